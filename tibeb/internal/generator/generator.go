@@ -257,6 +257,10 @@ func extractValidators(expr ast.Expr) []ValidatorCall {
 				args = append(args, basicLit.Value)
 			} else if ident, ok := arg.(*ast.Ident); ok {
 				args = append(args, ident.Name)
+			} else if sel, ok := arg.(*ast.SelectorExpr); ok {
+				if pkg, ok := sel.X.(*ast.Ident); ok {
+					args = append(args, pkg.Name+"."+sel.Sel.Name)
+				}
 			}
 		}
 
@@ -0,0 +1,138 @@
+// Package sanitize provides string transforms for cleaning user-generated
+// content. Each transform is a func(string) string, so it composes with the
+// validate package's transform pipeline and content is cleaned and
+// validated in one pass:
+//
+//	validate.String().MaxLen(280).
+//		Transform(sanitize.StripHTML).
+//		Pipe(sanitize.CollapseWhitespace)
+package sanitize
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Chain combines transforms into one that applies them in order
+func Chain(transforms ...func(string) string) func(string) string {
+	return func(s string) string {
+		for _, transform := range transforms {
+			s = transform(s)
+		}
+		return s
+	}
+}
+
+// StripHTML removes HTML tags and comments, dropping the contents of
+// script and style elements entirely. Entities such as &amp; are left as
+// they are. A '>' inside a quoted attribute value does not end its tag. A
+// '<' that does not start a tag is kept, while an unterminated tag is
+// dropped along with the rest of the input.
+func StripHTML(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] != '<' || !startsTag(s[i+1:]) {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], "<!--") {
+			end := strings.Index(s[i+4:], "-->")
+			if end < 0 {
+				break
+			}
+			i += 4 + end + 3
+			continue
+		}
+		end := tagEnd(s[i:])
+		if end < 0 {
+			break
+		}
+		name := tagName(s[i+1 : i+end])
+		i += end + 1
+		if name == "script" || name == "style" {
+			closing := strings.Index(strings.ToLower(s[i:]), "</"+name)
+			if closing < 0 {
+				break
+			}
+			i += closing
+		}
+	}
+	return b.String()
+}
+
+// startsTag reports whether the text after a '<' begins a tag, closing
+// tag, comment or declaration
+func startsTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	c := s[0]
+	return c == '/' || c == '!' || c == '?' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// tagEnd returns the index of the '>' closing the tag at the start of s,
+// skipping any inside single- or double-quoted attribute values, or -1
+func tagEnd(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i
+		}
+	}
+	return -1
+}
+
+// tagName returns the lowercase name of an opening tag, or "" for closing
+// tags, comments and declarations
+func tagName(tag string) string {
+	end := strings.IndexFunc(tag, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '/'
+	})
+	if end == 0 {
+		return ""
+	}
+	if end > 0 {
+		tag = tag[:end]
+	}
+	return strings.ToLower(tag)
+}
+
+// EscapeHTML escapes <, >, &, ' and " so the text displays literally when
+// embedded in HTML
+func EscapeHTML(s string) string {
+	return html.EscapeString(s)
+}
+
+// CollapseWhitespace replaces every run of whitespace, including newlines,
+// with a single space and trims the ends
+func CollapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// RemoveNonPrintable drops control, private-use and unassigned
+// characters, keeping newlines and tabs so multi-line text survives. All
+// Unicode spaces, such as the no-break space, and format characters, such
+// as the zero-width joiner in emoji sequences, are kept.
+func RemoveNonPrintable(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || unicode.IsGraphic(r) || unicode.In(r, unicode.Zl, unicode.Zp, unicode.Cf) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// NormalizeNewlines converts Windows (\r\n) and old Mac (\r) line endings
+// to \n
+func NormalizeNewlines(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
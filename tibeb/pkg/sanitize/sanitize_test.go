@@ -0,0 +1,61 @@
+package sanitize
+
+import "testing"
+
+func TestStripHTML(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"plain text", "hello", "hello"},
+		{"tags", "<b>bold</b> and <i>italic</i>", "bold and italic"},
+		{"quoted gt in attribute", `<a title="x>y">link</a>`, "link"},
+		{"single-quoted gt in attribute", `<a title='x>y'>link</a>`, "link"},
+		{"script contents", "a<script>alert(1)</script>b", "ab"},
+		{"style contents", "a<STYLE>p{}</style>b", "ab"},
+		{"comment", "a<!-- <b>hidden</b> -->b", "ab"},
+		{"less-than in text", "1 < 2", "1 < 2"},
+		{"unterminated tag", "a<b", "a"},
+		{"entities kept", "&amp;<br/>", "&amp;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripHTML(tt.in); got != tt.want {
+				t.Errorf("StripHTML(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveNonPrintable(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"controls", "a\x00b\x1bc\x7f", "abc"},
+		{"newlines and tabs", "a\nb\tc", "a\nb\tc"},
+		{"no-break space", "hello\u00a0world", "hello\u00a0world"},
+		{"thin and ideographic spaces", "a\u2009b\u3000c", "a\u2009b\u3000c"},
+		{"zero-width joiner", "\U0001F469\u200d\U0001F4BB", "\U0001F469\u200d\U0001F4BB"},
+		{"zero-width non-joiner", "می\u200cخ", "می\u200cخ"},
+		{"private use", "a\ue000b", "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveNonPrintable(tt.in); got != tt.want {
+				t.Errorf("RemoveNonPrintable(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	clean := Chain(StripHTML, NormalizeNewlines, CollapseWhitespace)
+	if got, want := clean("<p>one\r\n  two</p>"), "one two"; got != want {
+		t.Errorf("Chain = %q, want %q", got, want)
+	}
+}
+
+func TestEscapeHTML(t *testing.T) {
+	if got, want := EscapeHTML(`<a href="x">&</a>`), "&lt;a href=&#34;x&#34;&gt;&amp;&lt;/a&gt;"; got != want {
+		t.Errorf("EscapeHTML = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// AsyncValidator runs an I/O-backed rule, such as a database uniqueness
+// check, with an optional timeout and concurrency limit
+type AsyncValidator[T any] struct {
+	validate func(context.Context, T) *Error
+	timeout  time.Duration
+	sem      chan struct{}
+}
+
+var _ ContextValidator[string] = (*AsyncValidator[string])(nil)
+
+// AsyncCustom creates a validator for rules that call external systems.
+// The function receives the context given to ValidateContext, bounded by
+// Timeout if one is set.
+func AsyncCustom[T any](fn func(context.Context, T) *Error) *AsyncValidator[T] {
+	return &AsyncValidator[T]{
+		validate: fn,
+	}
+}
+
+// Timeout bounds each call of the rule; calls that take longer fail with a
+// timeout error even if the function ignores its context. The function
+// keeps running in its goroutine until it returns, so it should still
+// honor ctx to release resources promptly.
+func (v *AsyncValidator[T]) Timeout(d time.Duration) *AsyncValidator[T] {
+	v.timeout = d
+	return v
+}
+
+// Concurrency limits how many calls of the rule may run at the same time
+// across all goroutines sharing the validator. Calls abandoned by Timeout
+// count against the limit until they return. n <= 0 removes the limit.
+func (v *AsyncValidator[T]) Concurrency(n int) *AsyncValidator[T] {
+	if n <= 0 {
+		v.sem = nil
+		return v
+	}
+	v.sem = make(chan struct{}, n)
+	return v
+}
+
+// Validate implements the Validator interface using context.Background()
+func (v *AsyncValidator[T]) Validate(value T) *Error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *AsyncValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	if v.sem != nil {
+		select {
+		case v.sem <- struct{}{}:
+		case <-ctx.Done():
+			return contextError(ctx.Err())
+		}
+	}
+
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+
+	done := make(chan *Error, 1)
+	go func() {
+		if v.sem != nil {
+			defer func() { <-v.sem }()
+		}
+		done <- v.validate(ctx, value)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return &Error{
+				Code:    "timeout",
+				Message: "validation timed out",
+				Params:  map[string]any{"timeout": v.timeout.String()},
+				Cause:   ctx.Err(),
+			}
+		}
+		return contextError(ctx.Err())
+	}
+}
@@ -0,0 +1,106 @@
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+type signup struct {
+	Name    string
+	Age     int
+	Score   float64
+	Created time.Time
+	Tags    []string
+}
+
+func signupSchema() *Schema[signup] {
+	s := Struct[signup]().
+		Field(func(s signup) string { return s.Name }, String().Required().MinLen(2).MaxLen(20)).
+		Field(func(s signup) int { return s.Age }, Int().Min(1).Max(150)).
+		Field(func(s signup) float64 { return s.Score }, Float().Min(0).Max(10)).
+		Field(func(s signup) time.Time { return s.Created }, Time().Required())
+	return Field(s, func(s signup) []string { return s.Tags }, Each[string](String().MinLen(1)))
+}
+
+var validSignup = signup{
+	Name:    "abebe",
+	Age:     30,
+	Score:   7.5,
+	Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	Tags:    []string{"go"},
+}
+
+// TestValidateIntoAllocations guards the allocation-free path: a valid
+// value validated into reused errors must not allocate
+func TestValidateIntoAllocations(t *testing.T) {
+	schema := signupSchema()
+	errs := AcquireErrors()
+	defer errs.Release()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		schema.ValidateInto(validSignup, errs)
+	})
+	if errs.HasErrors() {
+		t.Fatalf("unexpected errors: %s", errs.Format())
+	}
+	if allocs != 0 {
+		t.Errorf("ValidateInto allocated %v times per run, want 0", allocs)
+	}
+}
+
+func TestValidateIntoResets(t *testing.T) {
+	schema := signupSchema()
+	errs := AcquireErrors()
+	defer errs.Release()
+
+	schema.ValidateInto(signup{}, errs)
+	if !errs.HasErrors() {
+		t.Fatal("expected errors for the zero value")
+	}
+	schema.ValidateInto(validSignup, errs)
+	if errs.HasErrors() {
+		t.Errorf("errors were not reset: %s", errs.Format())
+	}
+}
+
+func TestAcquireErrorsAfterRelease(t *testing.T) {
+	errs := AcquireErrors()
+	errs.Add(&Error{Field: "Name", Code: "required"})
+	errs.Release()
+
+	// the pool may or may not hand back the same Errors; either way it
+	// must be empty
+	for range 3 {
+		errs := AcquireErrors()
+		if errs.Len() != 0 {
+			t.Fatalf("acquired Errors holds %d errors, want 0", errs.Len())
+		}
+		errs.Release()
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	schema := signupSchema()
+	b.ReportAllocs()
+	for range b.N {
+		schema.Validate(validSignup)
+	}
+}
+
+func BenchmarkValidateInto(b *testing.B) {
+	schema := signupSchema()
+	errs := AcquireErrors()
+	defer errs.Release()
+	b.ReportAllocs()
+	for range b.N {
+		schema.ValidateInto(validSignup, errs)
+	}
+}
+
+func BenchmarkValidateInvalid(b *testing.B) {
+	schema := signupSchema()
+	b.ReportAllocs()
+	for range b.N {
+		schema.Validate(signup{Name: "a"})
+	}
+}
@@ -0,0 +1,158 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// BigIntValidator provides validation rules for *big.Int values
+type BigIntValidator struct {
+	overrides
+	min      *big.Int
+	max      *big.Int
+	positive bool
+	negative bool
+	required bool
+}
+
+var _ Validator[*big.Int] = (*BigIntValidator)(nil)
+
+// BigInt creates a new big integer validator
+func BigInt() *BigIntValidator {
+	return &BigIntValidator{}
+}
+
+// Min adds a minimum value validation rule
+func (v *BigIntValidator) Min(value *big.Int) *BigIntValidator {
+	v.min = new(big.Int).Set(value)
+	v.rule("Min")
+	return v
+}
+
+// Max adds a maximum value validation rule
+func (v *BigIntValidator) Max(value *big.Int) *BigIntValidator {
+	v.max = new(big.Int).Set(value)
+	v.rule("Max")
+	return v
+}
+
+// Positive requires the value to be positive (> 0)
+func (v *BigIntValidator) Positive() *BigIntValidator {
+	v.positive = true
+	v.rule("Positive")
+	return v
+}
+
+// Negative requires the value to be negative (< 0)
+func (v *BigIntValidator) Negative() *BigIntValidator {
+	v.negative = true
+	v.rule("Negative")
+	return v
+}
+
+// Required rejects nil values
+func (v *BigIntValidator) Required() *BigIntValidator {
+	v.required = true
+	v.rule("Required")
+	return v
+}
+
+// WithMessage replaces the message of the most recently added rule
+func (v *BigIntValidator) WithMessage(message string) *BigIntValidator {
+	v.setMessage(message)
+	return v
+}
+
+// WithCode replaces the error code of the most recently added rule
+func (v *BigIntValidator) WithCode(code string) *BigIntValidator {
+	v.setCode(code)
+	return v
+}
+
+// Compile reports rule combinations no value can satisfy, such as a
+// minimum greater than the maximum
+func (v *BigIntValidator) Compile() error {
+	switch {
+	case v.min != nil && v.max != nil && v.min.Cmp(v.max) > 0:
+		return fmt.Errorf("min %s is greater than max %s", v.min, v.max)
+	case v.positive && v.negative:
+		return errors.New("value cannot be both positive and negative")
+	}
+	return nil
+}
+
+// Validate implements the Validator[*big.Int] interface
+func (v *BigIntValidator) Validate(value *big.Int) *Error {
+	if errs := v.check(value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *BigIntValidator) ValidateAll(value *big.Int) []*Error {
+	return v.check(value, true)
+}
+
+// check runs the validation rules against value
+func (v *BigIntValidator) check(value *big.Int, all bool) []*Error {
+	var errs []*Error
+	fail := func(rule string, err *Error) bool {
+		errs = append(errs, v.apply(rule, err))
+		return !all
+	}
+
+	if value == nil {
+		if v.required {
+			return []*Error{v.apply("Required", &Error{
+				Code:    "required",
+				Message: "field is required",
+			})}
+		}
+		return nil
+	}
+
+	if v.min != nil && value.Cmp(v.min) < 0 {
+		if fail("Min", &Error{
+			Code:    "too_small",
+			Message: "value must be at least " + v.min.String(),
+			Params:  map[string]any{"min": v.min.String(), "actual": value.String()},
+		}) {
+			return errs
+		}
+	}
+
+	if v.max != nil && value.Cmp(v.max) > 0 {
+		if fail("Max", &Error{
+			Code:    "too_large",
+			Message: "value must be at most " + v.max.String(),
+			Params:  map[string]any{"max": v.max.String(), "actual": value.String()},
+		}) {
+			return errs
+		}
+	}
+
+	if v.positive && value.Sign() <= 0 {
+		if fail("Positive", &Error{
+			Code:    "not_positive",
+			Message: "value must be positive",
+			Params:  map[string]any{"actual": value.String()},
+		}) {
+			return errs
+		}
+	}
+
+	if v.negative && value.Sign() >= 0 {
+		if fail("Negative", &Error{
+			Code:    "not_negative",
+			Message: "value must be negative",
+			Params:  map[string]any{"actual": value.String()},
+		}) {
+			return errs
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,112 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// LoadTranslations registers the message catalogs in dir of fsys, one file
+// per locale named <locale>.json or <locale>.toml, so translations can ship
+// inside the binary with embed:
+//
+//	//go:embed locales
+//	var locales embed.FS
+//
+//	validate.LoadTranslations(locales, "locales") // locales/am.toml, locales/en.json
+//
+// A JSON catalog is an object of error code to template. A TOML catalog
+// holds one code = "template" pair per line; tables and other value types
+// are not supported. Other files are ignored.
+func LoadTranslations(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return fmt.Errorf("load translations: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		ext := path.Ext(name)
+		if ext != ".json" && ext != ".toml" {
+			continue
+		}
+		file := path.Join(dir, name)
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("load translations: %w", err)
+		}
+		messages := make(map[string]string)
+		if ext == ".json" {
+			err = json.Unmarshal(data, &messages)
+		} else {
+			err = parseTOMLCatalog(string(data), messages)
+		}
+		if err != nil {
+			return fmt.Errorf("load translations %s: %w", file, err)
+		}
+		RegisterTranslations(strings.TrimSuffix(name, ext), messages)
+	}
+	return nil
+}
+
+// parseTOMLCatalog reads flat key = "value" lines into messages
+func parseTOMLCatalog(data string, messages map[string]string) error {
+	for n, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("line %d: expected key = \"value\"", n+1)
+		}
+		key, err := tomlString(strings.TrimSpace(key), true)
+		if err != nil {
+			return fmt.Errorf("line %d: key: %w", n+1, err)
+		}
+		value, err = tomlString(strings.TrimSpace(value), false)
+		if err != nil {
+			return fmt.Errorf("line %d: value: %w", n+1, err)
+		}
+		messages[key] = value
+	}
+	return nil
+}
+
+// tomlString decodes a basic or literal TOML string, or a bare key when
+// bare is set, dropping any trailing comment
+func tomlString(s string, bare bool) (string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\\' {
+				i++
+				continue
+			}
+			if s[i] == '"' {
+				if rest := strings.TrimSpace(s[i+1:]); rest != "" && rest[0] != '#' {
+					return "", fmt.Errorf("unexpected %q after string", rest)
+				}
+				return strconv.Unquote(s[:i+1])
+			}
+		}
+		return "", fmt.Errorf("unterminated string %s", s)
+	case strings.HasPrefix(s, "'"):
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", s)
+		}
+		if rest := strings.TrimSpace(s[end+2:]); rest != "" && rest[0] != '#' {
+			return "", fmt.Errorf("unexpected %q after string", rest)
+		}
+		return s[1 : end+1], nil
+	case bare && s != "":
+		return s, nil
+	}
+	return "", fmt.Errorf("expected a quoted string, got %q", s)
+}
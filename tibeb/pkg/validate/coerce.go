@@ -0,0 +1,173 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Coerce builds validators for loosely typed input such as query
+// parameters, environment variables and decoded JSON, where numbers and
+// booleans often arrive as strings:
+//
+//	validate.Coerce.Int(validate.Int().Between(1, 100)).Validate(r.URL.Query().Get("page"))
+var Coerce coercions
+
+// coercions groups the Coerce constructors
+type coercions struct{}
+
+// CoerceValidator converts loosely typed values to T before validating them
+type CoerceValidator[T any] struct {
+	kind       string
+	convert    func(any) (T, error)
+	validators []Validator[T]
+}
+
+var _ MultiValidator[any] = (*CoerceValidator[int])(nil)
+
+// Int accepts integers of any size, integral floats, json.Number and
+// strings such as "42", then runs validators on the int
+func (coercions) Int(validators ...Validator[int]) *CoerceValidator[int] {
+	return &CoerceValidator[int]{kind: "integer", convert: coerceInt, validators: validators}
+}
+
+// Float accepts integers, floats, json.Number and strings such as "1.5",
+// then runs validators on the float64
+func (coercions) Float(validators ...Validator[float64]) *CoerceValidator[float64] {
+	return &CoerceValidator[float64]{kind: "number", convert: coerceFloat, validators: validators}
+}
+
+// Bool accepts booleans and strings accepted by strconv.ParseBool or
+// HTML forms, such as "true", "1", "on" and "no", then runs validators on
+// the bool
+func (coercions) Bool(validators ...Validator[bool]) *CoerceValidator[bool] {
+	return &CoerceValidator[bool]{kind: "boolean", convert: coerceBool, validators: validators}
+}
+
+// Compile reports misconfiguration of the validators
+func (v *CoerceValidator[T]) Compile() error {
+	for _, validator := range v.validators {
+		if err := compileValidator(validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert coerces value and validates the result, returning it
+func (v *CoerceValidator[T]) Convert(value any) (T, *Error) {
+	converted, err := v.coerce(value)
+	if err != nil {
+		return converted, err
+	}
+	for _, validator := range v.validators {
+		if err := validator.Validate(converted); err != nil {
+			return converted, err
+		}
+	}
+	return converted, nil
+}
+
+// Validate implements the Validator interface
+func (v *CoerceValidator[T]) Validate(value any) *Error {
+	_, err := v.Convert(value)
+	return err
+}
+
+// ValidateAll returns the coercion error or every rule the coerced value
+// violates
+func (v *CoerceValidator[T]) ValidateAll(value any) []*Error {
+	converted, err := v.coerce(value)
+	if err != nil {
+		return []*Error{err}
+	}
+	var errs []*Error
+	for _, validator := range v.validators {
+		errs = append(errs, validateAll(validator, converted)...)
+	}
+	return errs
+}
+
+// coerce converts value, reporting invalid_type when it cannot
+func (v *CoerceValidator[T]) coerce(value any) (T, *Error) {
+	converted, err := v.convert(value)
+	if err != nil {
+		return converted, &Error{
+			Code:    "invalid_type",
+			Message: "value is not a valid " + v.kind,
+			Params:  map[string]any{"type": v.kind, "actual": fmt.Sprint(value)},
+			Cause:   err,
+		}
+	}
+	return converted, nil
+}
+
+// coerceInt converts value to an int
+func coerceInt(value any) (int, error) {
+	switch value := value.(type) {
+	case string:
+		return strconv.Atoi(strings.TrimSpace(value))
+	case json.Number:
+		n, err := value.Int64()
+		if err != nil || n != int64(int(n)) {
+			return 0, fmt.Errorf("%s is not an int", value)
+		}
+		return int(n), nil
+	}
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.CanInt():
+		if n := rv.Int(); n == int64(int(n)) {
+			return int(n), nil
+		}
+	case rv.CanUint():
+		if n := rv.Uint(); n <= math.MaxInt {
+			return int(n), nil
+		}
+	case rv.CanFloat():
+		if f := rv.Float(); f == math.Trunc(f) && f >= math.MinInt && f < math.MaxInt {
+			return int(f), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot use %v (%T) as an int", value, value)
+}
+
+// coerceFloat converts value to a float64
+func coerceFloat(value any) (float64, error) {
+	switch value := value.(type) {
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(value), 64)
+	case json.Number:
+		return value.Float64()
+	}
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.CanFloat():
+		return rv.Float(), nil
+	case rv.CanInt():
+		return float64(rv.Int()), nil
+	case rv.CanUint():
+		return float64(rv.Uint()), nil
+	}
+	return 0, fmt.Errorf("cannot use %v (%T) as a float", value, value)
+}
+
+// coerceBool converts value to a bool
+func coerceBool(value any) (bool, error) {
+	switch value := value.(type) {
+	case bool:
+		return value, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "on", "yes", "y":
+			return true, nil
+		case "off", "no", "n":
+			return false, nil
+		}
+		return strconv.ParseBool(strings.TrimSpace(value))
+	}
+	return false, fmt.Errorf("cannot use %v (%T) as a bool", value, value)
+}
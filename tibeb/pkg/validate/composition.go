@@ -1,5 +1,7 @@
 package validate
 
+import "fmt"
+
 // OneOfValidator checks if at least one validator passes
 type OneOfValidator[T any] struct {
 	validators []Validator[T]
@@ -12,26 +14,126 @@ func OneOf[T any](validators ...Validator[T]) Validator[T] {
 	}
 }
 
-// Validate implements the Validator interface
+// Validate implements the Validator interface. When no validator passes,
+// the "failures" param holds each validator's error in order, so callers
+// can show why every alternative was rejected.
 func (v *OneOfValidator[T]) Validate(value T) *Error {
-	var lastError *Error
+	failures := make([]*Error, 0, len(v.validators))
 	for _, validator := range v.validators {
-		if err := validator.Validate(value); err == nil {
+		err := validator.Validate(value)
+		if err == nil {
 			return nil
-		} else {
-			lastError = err
 		}
+		failures = append(failures, err)
 	}
-	return &Error{
+	err := &Error{
 		Code:    "no_match",
 		Message: "value did not match any of the requirements",
-		Field:   lastError.Field,
+		Params:  map[string]any{"failures": failures},
+	}
+	if len(failures) > 0 {
+		err.Field = failures[len(failures)-1].Field
+	}
+	return err
+}
+
+// ExactlyOneOfValidator checks that exactly one validator passes
+type ExactlyOneOfValidator[T any] struct {
+	validators []Validator[T]
+}
+
+// ExactlyOneOf creates a new validator that passes if exactly one of the
+// given validators passes, for rules such as "provide either Phone or
+// Email, but not both"
+func ExactlyOneOf[T any](validators ...Validator[T]) Validator[T] {
+	return &ExactlyOneOfValidator[T]{
+		validators: validators,
+	}
+}
+
+// Validate implements the Validator interface. When no validator passes it
+// reports no_match with each validator's error in the "failures" param;
+// when several pass it reports multiple_matches with their positions in
+// the "matched" param.
+func (v *ExactlyOneOfValidator[T]) Validate(value T) *Error {
+	var matched []int
+	failures := make([]*Error, 0, len(v.validators))
+	for i, validator := range v.validators {
+		if err := validator.Validate(value); err != nil {
+			failures = append(failures, err)
+		} else {
+			matched = append(matched, i)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return &Error{
+			Code:    "no_match",
+			Message: "value did not match any of the requirements",
+			Params:  map[string]any{"failures": failures},
+		}
+	case 1:
+		return nil
+	}
+	return &Error{
+		Code:    "multiple_matches",
+		Message: "value must match exactly one of the requirements",
+		Params:  map[string]any{"matched": matched},
+	}
+}
+
+// AtLeastValidator checks that a minimum number of validators pass
+type AtLeastValidator[T any] struct {
+	n          int
+	validators []Validator[T]
+}
+
+// AtLeast creates a new validator that passes if at least n of the given
+// validators pass, e.g. a password policy requiring three of four
+// character classes:
+//
+//	validate.AtLeast(3, lower, upper, digit, symbol)
+func AtLeast[T any](n int, validators ...Validator[T]) Validator[T] {
+	return &AtLeastValidator[T]{
+		n:          n,
+		validators: validators,
+	}
+}
+
+// Compile reports a minimum that no value can reach
+func (v *AtLeastValidator[T]) Compile() error {
+	if v.n > len(v.validators) {
+		return fmt.Errorf("at least %d of %d validators can never pass", v.n, len(v.validators))
+	}
+	return nil
+}
+
+// Validate implements the Validator interface. On failure the "failures"
+// param holds the errors of the validators that did not pass.
+func (v *AtLeastValidator[T]) Validate(value T) *Error {
+	var failures []*Error
+	matched := 0
+	for _, validator := range v.validators {
+		if err := validator.Validate(value); err != nil {
+			failures = append(failures, err)
+		} else {
+			matched++
+		}
+	}
+	if matched >= v.n {
+		return nil
+	}
+	return &Error{
+		Code:    "too_few_matches",
+		Message: fmt.Sprintf("value must meet at least %d of %d requirements", v.n, len(v.validators)),
+		Params:  map[string]any{"min": v.n, "actual": matched, "failures": failures},
 	}
 }
 
 // AllOfValidator checks if all validators pass
 type AllOfValidator[T any] struct {
 	validators []Validator[T]
+	collect    bool
 }
 
 // AllOf creates a new validator that passes if all of the given validators pass
@@ -41,6 +143,15 @@ func AllOf[T any](validators ...Validator[T]) Validator[T] {
 	}
 }
 
+// AllOfCollect is like AllOf but runs every validator and reports all of
+// their failures, even in a schema that does not use CollectAll
+func AllOfCollect[T any](validators ...Validator[T]) *AllOfValidator[T] {
+	return &AllOfValidator[T]{
+		validators: validators,
+		collect:    true,
+	}
+}
+
 // Validate implements the Validator interface
 func (v *AllOfValidator[T]) Validate(value T) *Error {
 	for _, validator := range v.validators {
@@ -51,6 +162,90 @@ func (v *AllOfValidator[T]) Validate(value T) *Error {
 	return nil
 }
 
+// ValidateAll returns the failures of every validator
+func (v *AllOfValidator[T]) ValidateAll(value T) []*Error {
+	var errs []*Error
+	for _, validator := range v.validators {
+		errs = append(errs, validateAll(validator, value)...)
+	}
+	return errs
+}
+
+// collectsAll reports whether the validator was built by AllOfCollect
+func (v *AllOfValidator[T]) collectsAll() bool {
+	return v.collect
+}
+
+// collector is implemented by validators that report every failure even
+// when the schema stops at the first failure of each field
+type collector interface {
+	collectsAll() bool
+}
+
+// collectsAll reports whether v always reports every failure
+func collectsAll(v any) bool {
+	c, ok := v.(collector)
+	return ok && c.collectsAll()
+}
+
+// IfValidator picks the validator to run depending on whether a condition
+// validator passes
+type IfValidator[T any] struct {
+	cond      Validator[T]
+	then      Validator[T]
+	otherwise Validator[T]
+}
+
+// If creates a new validator that runs the Then validator when cond passes
+// and the Else validator when it fails, e.g.
+//
+//	validate.If[string](validate.String().EndsWith("@corp.example")).
+//		Then(validate.String().MaxLen(64)).
+//		Else(validate.String().Email())
+//
+// The failure of cond itself is never reported, and a missing branch passes
+func If[T any](cond Validator[T]) *IfValidator[T] {
+	return &IfValidator[T]{
+		cond: cond,
+	}
+}
+
+// Then sets the validator run when the condition passes
+func (v *IfValidator[T]) Then(validator Validator[T]) *IfValidator[T] {
+	v.then = validator
+	return v
+}
+
+// Else sets the validator run when the condition fails
+func (v *IfValidator[T]) Else(validator Validator[T]) *IfValidator[T] {
+	v.otherwise = validator
+	return v
+}
+
+// branch returns the validator selected for value, or nil
+func (v *IfValidator[T]) branch(value T) Validator[T] {
+	if v.cond.Validate(value) == nil {
+		return v.then
+	}
+	return v.otherwise
+}
+
+// Validate implements the Validator interface
+func (v *IfValidator[T]) Validate(value T) *Error {
+	if branch := v.branch(value); branch != nil {
+		return branch.Validate(value)
+	}
+	return nil
+}
+
+// ValidateAll returns every failure of the selected branch
+func (v *IfValidator[T]) ValidateAll(value T) []*Error {
+	if branch := v.branch(value); branch != nil {
+		return validateAll(branch, value)
+	}
+	return nil
+}
+
 // NotValidator inverts the result of another validator
 type NotValidator[T any] struct {
 	validator Validator[T]
@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type account struct {
+	Name  string
+	Email string
+	Age   int
+}
+
+// errShared is returned by every call of a Custom rule, like a
+// package-level sentinel error would be
+var errShared = &Error{Code: "taken", Message: "name is taken"}
+
+func sharedSchema() *Schema[account] {
+	return Struct[account]().
+		Field(func(a account) string { return a.Name }, String().Custom(func(string) *Error { return errShared })).
+		Label("Name").
+		Field(func(a account) string { return a.Email }, String().Email().WithMessage("bad email")).
+		Field(func(a account) int { return a.Age }, Int().Min(18)).
+		Check("adult_email", func(account) *Error { return errShared })
+}
+
+// TestSchemaConcurrentUse validates with one schema from many goroutines;
+// run with -race. Shared errors returned by validators must come back
+// unchanged and every call must see the same result.
+func TestSchemaConcurrentUse(t *testing.T) {
+	schema := sharedSchema()
+	value := account{Name: "x", Email: "nope", Age: 3}
+	want := schema.Validate(value).Format()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got := schema.Validate(value).Format(); got != want {
+					t.Errorf("Validate = %q, want %q", got, want)
+					return
+				}
+				if _, errs := schema.Parse(value); errs.Format() != want {
+					t.Errorf("Parse = %q, want %q", errs.Format(), want)
+					return
+				}
+				if got := schema.ValidateContext(context.Background(), value).Format(); got != want {
+					t.Errorf("ValidateContext = %q, want %q", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if errShared.Field != "" || errShared.Path != nil || errShared.Message != "name is taken" {
+		t.Errorf("shared error was modified: %+v", errShared)
+	}
+}
@@ -0,0 +1,52 @@
+package validate
+
+// When adds the rules configured by build so that they only run when cond
+// reports true for the value being validated, e.g.
+//
+//	schema.When(func(a Account) bool { return a.Type == "business" }, func(s *validate.Schema[Account]) {
+//		s.Field(func(a Account) string { return a.Company }, validate.String().Required())
+//	})
+func (s *Schema[T]) When(cond func(T) bool, build func(*Schema[T])) *Schema[T] {
+	branch := Struct[T]()
+	build(branch)
+
+	for _, rule := range branch.rules {
+		rule.when = andCond(cond, rule.when)
+		s.rules = append(s.rules, rule)
+	}
+	for _, refine := range branch.refinements {
+		s.refinements = append(s.refinements, func(value T) *Error {
+			if !cond(value) {
+				return nil
+			}
+			return refine(value)
+		})
+	}
+	return s
+}
+
+// RequiredIf changes the most recently added field rule so that a zero
+// field is reported as required when cond reports true and is skipped
+// otherwise; non-zero fields are validated as usual
+func (s *Schema[T]) RequiredIf(cond func(T) bool) *Schema[T] {
+	s.lastRule().requiredIf = cond
+	return s
+}
+
+// lastRule returns the most recently added field rule
+func (s *Schema[T]) lastRule() *FieldRule[T] {
+	if len(s.rules) == 0 {
+		panic("validate: no field rule to modify; call Field first")
+	}
+	return &s.rules[len(s.rules)-1]
+}
+
+// andCond combines two optional conditions
+func andCond[T any](a, b func(T) bool) func(T) bool {
+	if b == nil {
+		return a
+	}
+	return func(value T) bool {
+		return a(value) && b(value)
+	}
+}
@@ -0,0 +1,159 @@
+package validate
+
+import "context"
+
+// ContextValidator is implemented by validators that need a context, for
+// deadlines, cancellation or request-scoped data such as a tenant ID.
+// Schemas call ValidateContext instead of Validate when it is available.
+type ContextValidator[T any] interface {
+	Validator[T]
+	ValidateContext(ctx context.Context, value T) *Error
+}
+
+// MultiContextValidator is implemented by validators that can report every
+// error in one pass with a context. Schemas collecting every error call
+// ValidateAllContext instead of ValidateAll when it is available.
+type MultiContextValidator[T any] interface {
+	ValidateAllContext(ctx context.Context, value T) []*Error
+}
+
+// validateAllContext runs every rule of v with ctx when v supports it and
+// falls back to validateAll otherwise
+func validateAllContext[T any](ctx context.Context, v Validator[T], value T) []*Error {
+	if m, ok := v.(MultiContextValidator[T]); ok {
+		return m.ValidateAllContext(ctx, value)
+	}
+	return validateAll(v, value)
+}
+
+// validateContext runs v with ctx if it is context-aware
+func validateContext[T any](ctx context.Context, v Validator[T], value T) *Error {
+	if cv, ok := v.(ContextValidator[T]); ok {
+		return cv.ValidateContext(ctx, value)
+	}
+	return v.Validate(value)
+}
+
+// contextError reports that validation stopped because ctx is done
+func contextError(err error) *Error {
+	return &Error{
+		Code:    "canceled",
+		Message: "validation canceled: " + err.Error(),
+		Cause:   err,
+	}
+}
+
+// CustomContextValidator is a custom validation rule that receives a context
+type CustomContextValidator[T any] struct {
+	validate func(context.Context, T) *Error
+}
+
+var _ ContextValidator[string] = (*CustomContextValidator[string])(nil)
+
+// CustomContext creates a custom validator whose function receives the
+// context passed to ValidateContext, or context.Background() otherwise
+func CustomContext[T any](validate func(context.Context, T) *Error) *CustomContextValidator[T] {
+	return &CustomContextValidator[T]{
+		validate: validate,
+	}
+}
+
+// Validate implements the Validator interface
+func (v *CustomContextValidator[T]) Validate(value T) *Error {
+	return v.validate(context.Background(), value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *CustomContextValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return v.validate(ctx, value)
+}
+
+// ValidateContext validates the nested value, passing ctx to its schema
+func (v *NestedValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return firstFailure(v.schema.ValidateContext(ctx, value))
+}
+
+// ValidateAllContext returns every error of the nested schema, passing ctx
+// to it
+func (v *NestedValidator[T]) ValidateAllContext(ctx context.Context, value T) []*Error {
+	return childErrors(v.schema.ValidateContext(ctx, value))
+}
+
+// ValidateContext validates the pointed-to value, passing ctx to its schema
+func (v *NestedPtrValidator[T]) ValidateContext(ctx context.Context, value *T) *Error {
+	if value == nil {
+		return v.nilError()
+	}
+	return firstFailure(v.schema.ValidateContext(ctx, *value))
+}
+
+// ValidateAllContext returns every error of the nested schema, passing ctx
+// to it
+func (v *NestedPtrValidator[T]) ValidateAllContext(ctx context.Context, value *T) []*Error {
+	if value == nil {
+		return v.nilErrors()
+	}
+	return childErrors(v.schema.ValidateContext(ctx, *value))
+}
+
+// ValidateContext validates every map value, passing ctx to the schema
+func (v *MapNestedValidator[K, T]) ValidateContext(ctx context.Context, values map[K]T) *Error {
+	for _, key := range orderedKeys(values) {
+		if err := firstFailure(v.schema.ValidateContext(ctx, values[key.key])); err != nil {
+			return err.withPrefix(KeySegment(key.name))
+		}
+	}
+	return nil
+}
+
+// ValidateAllContext returns the errors of every invalid map value,
+// passing ctx to the schema
+func (v *MapNestedValidator[K, T]) ValidateAllContext(ctx context.Context, values map[K]T) []*Error {
+	var errs []*Error
+	for _, key := range orderedKeys(values) {
+		for _, err := range childErrors(v.schema.ValidateContext(ctx, values[key.key])) {
+			errs = append(errs, err.withPrefix(KeySegment(key.name)))
+		}
+	}
+	return errs
+}
+
+// ValidateContext validates every element, passing ctx to the element
+// validator
+func (v *EachValidator[T]) ValidateContext(ctx context.Context, values []T) *Error {
+	for i, value := range values {
+		if err := ctx.Err(); err != nil {
+			return contextError(err)
+		}
+		if err := validateContext(ctx, v.validator, value); err != nil {
+			return err.withPrefix(IndexSegment(i))
+		}
+	}
+	return nil
+}
+
+// ValidateAllContext returns the errors of every invalid element, passing
+// ctx to the element validator
+func (v *EachValidator[T]) ValidateAllContext(ctx context.Context, values []T) []*Error {
+	var errs []*Error
+	for i, value := range values {
+		if err := ctx.Err(); err != nil {
+			return append(errs, contextError(err))
+		}
+		for _, err := range validateAllContext(ctx, v.validator, value) {
+			if v.maxErrors > 0 && len(errs) >= v.maxErrors {
+				return errs
+			}
+			errs = append(errs, err.withPrefix(IndexSegment(i)))
+		}
+	}
+	return errs
+}
+
+// ValidateContext validates the object, passing ctx to key validators
+func (v *ObjectValidator) ValidateContext(ctx context.Context, value map[string]any) *Error {
+	if errs := v.check(ctx, value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
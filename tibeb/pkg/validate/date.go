@@ -0,0 +1,205 @@
+package validate
+
+import (
+	"errors"
+	"time"
+)
+
+// timeRule marks the most recently added rule of a DateValidator as one
+// of its TimeValidator's, which applies that rule's overrides itself
+const timeRule = "time"
+
+// DateValidator validates strings carrying dates in a fixed layout and
+// applies TimeValidator rules to the parsed value
+type DateValidator struct {
+	overrides
+	layout   string
+	time     *TimeValidator
+	required bool
+}
+
+var _ Validator[string] = (*DateValidator)(nil)
+
+// Date creates a new date string validator for the given time layout,
+// e.g. validate.Date("2006-01-02")
+func Date(layout string) *DateValidator {
+	return &DateValidator{
+		// WithMessage right after Date customizes the parse failure
+		overrides: overrides{last: "Date"},
+		layout:    layout,
+		time:      Time(),
+	}
+}
+
+// After adds validation that the date must be after the given time
+func (v *DateValidator) After(t time.Time) *DateValidator {
+	v.time.After(t)
+	v.rule(timeRule)
+	return v
+}
+
+// Before adds validation that the date must be before the given time
+func (v *DateValidator) Before(t time.Time) *DateValidator {
+	v.time.Before(t)
+	v.rule(timeRule)
+	return v
+}
+
+// Between adds validation that the date must be between two times
+func (v *DateValidator) Between(start, end time.Time) *DateValidator {
+	v.time.Between(start, end)
+	v.rule(timeRule)
+	return v
+}
+
+// Future requires the date to be in the future
+func (v *DateValidator) Future() *DateValidator {
+	v.time.Future()
+	v.rule(timeRule)
+	return v
+}
+
+// Past requires the date to be in the past
+func (v *DateValidator) Past() *DateValidator {
+	v.time.Past()
+	v.rule(timeRule)
+	return v
+}
+
+// WithinPast requires the date to fall within the given duration before now
+func (v *DateValidator) WithinPast(d time.Duration) *DateValidator {
+	v.time.WithinPast(d)
+	v.rule(timeRule)
+	return v
+}
+
+// WithinNext requires the date to fall within the given duration after now
+func (v *DateValidator) WithinNext(d time.Duration) *DateValidator {
+	v.time.WithinNext(d)
+	v.rule(timeRule)
+	return v
+}
+
+// Weekdays requires the date to fall on one of the given days
+func (v *DateValidator) Weekdays(days ...time.Weekday) *DateValidator {
+	v.time.Weekdays(days...)
+	v.rule(timeRule)
+	return v
+}
+
+// NotWeekdays requires the date not to fall on any of the given days;
+// repeated calls add to the excluded days
+func (v *DateValidator) NotWeekdays(days ...time.Weekday) *DateValidator {
+	v.time.NotWeekdays(days...)
+	v.rule(timeRule)
+	return v
+}
+
+// MinAge treats the date as a birthdate and requires a minimum age in years
+func (v *DateValidator) MinAge(years int) *DateValidator {
+	v.time.MinAge(years)
+	v.rule(timeRule)
+	return v
+}
+
+// MaxAge treats the date as a birthdate and requires a maximum age in years
+func (v *DateValidator) MaxAge(years int) *DateValidator {
+	v.time.MaxAge(years)
+	v.rule(timeRule)
+	return v
+}
+
+// Clock sets the function used to obtain the current time
+func (v *DateValidator) Clock(now func() time.Time) *DateValidator {
+	v.time.Clock(now)
+	return v
+}
+
+// Custom adds a custom validation function for the parsed date
+func (v *DateValidator) Custom(fn func(time.Time) *Error) *DateValidator {
+	v.time.Custom(fn)
+	v.rule(timeRule)
+	return v
+}
+
+// Required marks the field as required
+func (v *DateValidator) Required() *DateValidator {
+	v.required = true
+	v.time.Required()
+	v.rule("Required")
+	return v
+}
+
+// WithMessage replaces the message of the most recently added rule; right
+// after Date it replaces the message for unparseable dates
+func (v *DateValidator) WithMessage(message string) *DateValidator {
+	if v.last == timeRule || v.last == "Required" {
+		v.time.WithMessage(message)
+	}
+	if v.last != timeRule {
+		v.setMessage(message)
+	}
+	return v
+}
+
+// WithCode replaces the error code of the most recently added rule; right
+// after Date it replaces invalid_date
+func (v *DateValidator) WithCode(code string) *DateValidator {
+	if v.last == timeRule || v.last == "Required" {
+		v.time.WithCode(code)
+	}
+	if v.last != timeRule {
+		v.setCode(code)
+	}
+	return v
+}
+
+// Compile reports an empty layout or misconfiguration of the time rules
+func (v *DateValidator) Compile() error {
+	if v.layout == "" {
+		return errors.New("empty date layout")
+	}
+	return v.time.Compile()
+}
+
+// Validate parses the string with the configured layout and validates
+// the resulting time
+func (v *DateValidator) Validate(value string) *Error {
+	if errs := v.check(value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *DateValidator) ValidateAll(value string) []*Error {
+	return v.check(value, true)
+}
+
+// check parses the value and runs the time rules against the result
+func (v *DateValidator) check(value string, all bool) []*Error {
+	if value == "" {
+		if v.required {
+			return []*Error{v.apply("Required", &Error{
+				Field:   "",
+				Code:    "required",
+				Message: "field is required",
+			})}
+		}
+		return nil
+	}
+
+	t, err := time.Parse(v.layout, value)
+	if err != nil {
+		return []*Error{v.apply("Date", &Error{
+			Field:   "",
+			Code:    "invalid_date",
+			Message: "must be a date in the format " + v.layout,
+			Params:  map[string]any{"layout": v.layout},
+			Cause:   err,
+		})}
+	}
+
+	return v.time.check(t, all)
+}
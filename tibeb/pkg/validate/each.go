@@ -0,0 +1,70 @@
+package validate
+
+// EachValidator validates every element of a slice
+type EachValidator[T any] struct {
+	validator Validator[T]
+	maxErrors int
+	collect   bool
+}
+
+var _ Validator[[]string] = (*EachValidator[string])(nil)
+
+// Each creates a validator that applies the given validator to every
+// element of a slice, reporting errors with the element index, e.g. Tags[3]
+func Each[T any](validator Validator[T]) *EachValidator[T] {
+	return &EachValidator[T]{
+		validator: validator,
+	}
+}
+
+// EachNested creates a validator for slices of structs, such as an order's
+// line items, that validates every element with schema and reports all of
+// their errors with indexed paths, e.g. Items[2].SKU
+func EachNested[T any](schema *Schema[T]) *EachValidator[T] {
+	return &EachValidator[T]{
+		validator: &NestedValidator[T]{schema: schema},
+		collect:   true,
+	}
+}
+
+// MaxErrors makes ValidateAll stop after n element errors, for large
+// batches whose response will be truncated anyway
+func (v *EachValidator[T]) MaxErrors(n int) *EachValidator[T] {
+	v.maxErrors = n
+	return v
+}
+
+// Compile reports misconfiguration of the element validator
+func (v *EachValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface, returning the error of
+// the first invalid element
+func (v *EachValidator[T]) Validate(values []T) *Error {
+	for i, value := range values {
+		if err := v.validator.Validate(value); err != nil {
+			return err.withPrefix(IndexSegment(i))
+		}
+	}
+	return nil
+}
+
+// collectsAll reports whether the validator was built by EachNested
+func (v *EachValidator[T]) collectsAll() bool {
+	return v.collect
+}
+
+// ValidateAll returns the errors of every invalid element
+func (v *EachValidator[T]) ValidateAll(values []T) []*Error {
+	var errs []*Error
+	for i, value := range values {
+		for _, err := range validateAll(v.validator, value) {
+			if v.maxErrors > 0 && len(errs) >= v.maxErrors {
+				return errs
+			}
+			errs = append(errs, err.withPrefix(IndexSegment(i)))
+		}
+	}
+	return errs
+}
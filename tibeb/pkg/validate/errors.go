@@ -0,0 +1,234 @@
+package validate
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Error implements the error interface, e.g. "Email: must be a valid email
+// address"
+func (e *Error) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Is reports whether target is an *Error with the same Code, and the same
+// Field when target sets one, so errors.Is(err, &validate.Error{Code:
+// "required"}) matches any required error
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return t.Code == e.Code && (t.Field == "" || t.Field == e.Field)
+}
+
+// Unwrap returns the underlying cause, if any, so errors.Is and errors.As
+// can tell infrastructure failures from invalid input
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Error implements the error interface by joining the message of every
+// error other than warnings
+func (e *Errors) Error() string {
+	var messages []string
+	for _, err := range e.failures() {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual errors other than warnings so errors.Is
+// and errors.As can inspect them
+func (e *Errors) Unwrap() []error {
+	var errs []error
+	for _, err := range e.failures() {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Err returns e as an error, or nil if there are no errors other than
+// warnings, for returning validation results up a normal Go call chain:
+//
+//	if err := schema.Validate(user).Err(); err != nil {
+//		return fmt.Errorf("create user: %w", err)
+//	}
+func (e *Errors) Err() error {
+	if !e.HasErrors() {
+		return nil
+	}
+	return e
+}
+
+// ByField groups the errors by field, keeping their order within each field
+func (e *Errors) ByField() map[string][]*Error {
+	fields := make(map[string][]*Error)
+	for _, err := range e.errors {
+		fields[err.Field] = append(fields[err.Field], err)
+	}
+	return fields
+}
+
+// Flatten returns the first error message for each field, the shape most
+// HTTP handlers and form renderers need
+func (e *Errors) Flatten() map[string]string {
+	fields := make(map[string]string)
+	for _, err := range e.errors {
+		if _, ok := fields[err.Field]; !ok {
+			fields[err.Field] = err.Message
+		}
+	}
+	return fields
+}
+
+// failures returns the errors other than warnings
+func (e *Errors) failures() []*Error {
+	var errs []*Error
+	for _, err := range e.errors {
+		if !err.IsWarning() {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Has reports whether any error was reported for field
+func (e *Errors) Has(field string) bool {
+	for _, err := range e.errors {
+		if err.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ByCode returns the errors with the given code
+func (e *Errors) ByCode(code string) []*Error {
+	var errs []*Error
+	for _, err := range e.errors {
+		if err.Code == code {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// First returns the first error, or nil if there are none
+func (e *Errors) First() *Error {
+	if len(e.errors) == 0 {
+		return nil
+	}
+	return e.errors[0]
+}
+
+// Len returns the number of errors, including warnings
+func (e *Errors) Len() int {
+	return len(e.errors)
+}
+
+// Merge appends the errors of other to e, prefixing their paths with prefix
+// unless it is empty, to combine results from several sources such as a
+// request's body, query and headers. other is not modified.
+func (e *Errors) Merge(other *Errors, prefix string) *Errors {
+	if other == nil {
+		return e
+	}
+	var segments Path
+	if prefix != "" {
+		segments = dottedPath(prefix)
+	}
+	for _, err := range other.errors {
+		merged := *err
+		merged.Path = errorPath(err)
+		e.Add(merged.withPrefix(segments...))
+	}
+	return e
+}
+
+// Sort orders the errors by path, keeping the reporting order of errors
+// with the same path, so output does not depend on the order rules were
+// declared in. It returns e for chaining.
+func (e *Errors) Sort() *Errors {
+	slices.SortStableFunc(e.errors, func(a, b *Error) int {
+		return errorPath(a).compare(errorPath(b))
+	})
+	return e
+}
+
+// Format renders the errors as indented plain text grouped by field, in the
+// order fields were first reported, for CLI output and log lines:
+//
+//	Email:
+//	  - must be a valid email address (invalid_email)
+//	Tags[2]:
+//	  - must be at least 2 characters (too_short)
+//	  - warning: should be lowercase (not_lowercase)
+//
+// Errors without a field are listed first without a heading
+func (e *Errors) Format() string {
+	var b strings.Builder
+	var fields []string
+	groups := e.ByField()
+	for _, err := range e.errors {
+		if err.Field != "" && !slices.Contains(fields, err.Field) {
+			fields = append(fields, err.Field)
+		}
+	}
+	for _, err := range groups[""] {
+		writeFormatted(&b, "", err)
+	}
+	for _, field := range fields {
+		b.WriteString(field + ":\n")
+		for _, err := range groups[field] {
+			writeFormatted(&b, "  ", err)
+		}
+	}
+	return b.String()
+}
+
+// String returns the Format rendering
+func (e *Errors) String() string {
+	return e.Format()
+}
+
+// writeFormatted writes a single Format line for err
+func writeFormatted(b *strings.Builder, indent string, err *Error) {
+	b.WriteString(indent + "- ")
+	if err.IsWarning() {
+		b.WriteString("warning: ")
+	}
+	b.WriteString(err.Message)
+	if err.Code != "" {
+		b.WriteString(" (" + err.Code + ")")
+	}
+	b.WriteByte('\n')
+}
+
+// errorsPool holds released Errors for AcquireErrors
+var errorsPool = sync.Pool{
+	New: func() any { return &Errors{} },
+}
+
+// AcquireErrors returns an empty Errors from a shared pool, for use with
+// Schema.ValidateInto. Call Release once the errors are no longer needed.
+func AcquireErrors() *Errors {
+	return errorsPool.Get().(*Errors)
+}
+
+// Release resets e and returns it to the pool used by AcquireErrors.
+// Neither e nor the slices returned by its methods may be used afterwards.
+func (e *Errors) Release() {
+	e.Reset()
+	errorsPool.Put(e)
+}
+
+// Reset removes every error, keeping the allocated storage for reuse
+func (e *Errors) Reset() {
+	clear(e.errors)
+	e.errors = e.errors[:0]
+}
@@ -0,0 +1,271 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxProbeDepth bounds how deep probe values are filled, so self-referential
+// types such as linked lists do not recurse forever
+const maxProbeDepth = 4
+
+// fieldName resolves the name of the struct field a selector returns.
+// Each exported field of T is set to a non-zero probe value in turn and
+// the field whose probe changes the selector's result is reported. Fields
+// of nested and embedded structs are searched the same way and reported
+// as a dotted path such as Audit.CreatedBy, which also covers promoted
+// fields. When probing is inconclusive, the first field whose type
+// matches the selector's result type is used and resolved is false.
+// Probe values are built once per type and shared by every schema.
+func fieldName[T any](selector func(T) any, resultType reflect.Type) (name string, resolved bool) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return "", true
+	}
+
+	if name := probeFields(selector, rootProbeNode(t), resultType); name != "" {
+		return name, true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Type == resultType {
+			return t.Field(i).Name, false
+		}
+	}
+	return "", false
+}
+
+// probeCache holds the root probeNode of each struct type fieldName has
+// probed, keyed by reflect.Type
+var probeCache sync.Map
+
+// probeNode holds the probe values for the fields of the struct reached
+// through index from the root type. Probe values are root values boxed
+// in an any, so probing a selector needs no reflection besides comparing
+// results.
+type probeNode struct {
+	// base is a root value with the struct reached but no field filled
+	base   any
+	fields []probeField
+	depth  int
+}
+
+// probeField holds the probe of one field of a probeNode
+type probeField struct {
+	name string
+	typ  reflect.Type
+	// probe is a root value with the field filled, nil when it cannot be
+	probe any
+	// promoted marks an unexported embedded struct, whose fields are
+	// searched without probing the struct itself
+	promoted bool
+	// inner returns the node of the struct the field holds, or is nil
+	inner func() *probeNode
+}
+
+// rootProbeNode returns the cached probe node of root, building it on
+// first use
+func rootProbeNode(root reflect.Type) *probeNode {
+	if node, ok := probeCache.Load(root); ok {
+		return node.(*probeNode)
+	}
+	node, _ := probeCache.LoadOrStore(root, newProbeNode(root, root, nil))
+	return node.(*probeNode)
+}
+
+// newProbeNode builds the probe node of struct type t reached through
+// index from root. Nodes of nested structs are built on first use.
+func newProbeNode(root, t reflect.Type, index []int) *probeNode {
+	base := reflect.New(root).Elem()
+	reachStruct(base, index)
+	node := &probeNode{base: base.Interface(), depth: len(index)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		path := append(index[:len(index):len(index)], i)
+		if !field.IsExported() {
+			// promoted fields of an unexported embedded struct are still
+			// settable even though the struct itself is not
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				node.fields = append(node.fields, probeField{
+					promoted: true,
+					inner:    innerProbeNode(root, field.Type, path),
+				})
+			}
+			continue
+		}
+		entry := probeField{name: field.Name, typ: field.Type}
+		probe := reflect.New(root).Elem()
+		if fillProbe(reachStruct(probe, index).Field(i), 0) {
+			entry.probe = probe.Interface()
+		}
+		if inner := structType(field.Type); inner != nil {
+			entry.inner = innerProbeNode(root, inner, path)
+		}
+		node.fields = append(node.fields, entry)
+	}
+	return node
+}
+
+// innerProbeNode returns a function building the node of a nested struct
+// once
+func innerProbeNode(root, t reflect.Type, index []int) func() *probeNode {
+	return sync.OnceValue(func() *probeNode {
+		return newProbeNode(root, t, index)
+	})
+}
+
+// probeFields probes the fields of node, descending into struct fields
+// whose probe changes the selector's result unless the field itself has
+// the result type
+func probeFields[T any](selector func(T) any, node *probeNode, resultType reflect.Type) string {
+	if node.depth > maxProbeDepth {
+		return ""
+	}
+
+	base, baseOK := probeCall(selector, node.base.(T))
+
+	for _, field := range node.fields {
+		if field.promoted {
+			if name := probeFields(selector, field.inner(), resultType); name != "" {
+				return name
+			}
+			continue
+		}
+		if field.probe == nil {
+			continue
+		}
+		result, ok := probeCall(selector, field.probe.(T))
+		if ok == baseOK && (!ok || reflect.DeepEqual(result, base)) {
+			continue
+		}
+		if field.inner != nil && field.typ != resultType {
+			if name := probeFields(selector, field.inner(), resultType); name != "" {
+				return field.name + "." + name
+			}
+		}
+		return field.name
+	}
+	return ""
+}
+
+// structType returns the struct type t holds directly or through a
+// pointer, or nil for other types and for time.Time
+func structType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil
+	}
+	return t
+}
+
+// reachStruct walks index from v, allocating nil pointers on the way, and
+// returns the struct value it ends at
+func reachStruct(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		v = v.Field(i)
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+	}
+	return v
+}
+
+// fieldByPath returns the struct field of t named by a dotted path such as
+// Audit.CreatedBy, reporting false if any step does not exist
+func fieldByPath(t reflect.Type, path string) ([]reflect.StructField, bool) {
+	var fields []reflect.StructField
+	for _, name := range strings.Split(path, ".") {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, false
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return nil, false
+		}
+		fields = append(fields, f)
+		t = f.Type
+	}
+	return fields, true
+}
+
+// probeCall calls selector, reporting false if it panics (for example by
+// dereferencing a nil pointer field)
+func probeCall[T any](selector func(T) any, value T) (result any, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return selector(value), true
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// fillProbe sets v to a non-zero value and reports whether it succeeded
+func fillProbe(v reflect.Value, depth int) bool {
+	if !v.CanSet() || depth > maxProbeDepth {
+		return false
+	}
+
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(time.Unix(1, 0)))
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("probe")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(1)
+	case reflect.Pointer:
+		p := reflect.New(v.Type().Elem())
+		fillProbe(p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		fillProbe(s.Index(0), depth+1)
+		v.Set(s)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+	case reflect.Chan:
+		v.Set(reflect.MakeChan(v.Type(), 0))
+	case reflect.Array:
+		filled := false
+		for i := 0; i < v.Len(); i++ {
+			filled = fillProbe(v.Index(i), depth+1) || filled
+		}
+		return filled
+	case reflect.Struct:
+		filled := false
+		for i := 0; i < v.NumField(); i++ {
+			filled = fillProbe(v.Field(i), depth+1) || filled
+		}
+		return filled
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return false
+		}
+		v.Set(reflect.ValueOf("probe"))
+	default:
+		return false
+	}
+	return true
+}
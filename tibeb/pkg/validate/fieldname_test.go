@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"sync"
+	"testing"
+)
+
+type profile struct {
+	Email string `json:"email"`
+}
+
+type member struct {
+	Nick    string
+	Profile profile
+}
+
+func TestFieldNameNested(t *testing.T) {
+	errs := Struct[member]().
+		Field(func(m member) string { return m.Nick }, String().Required()).
+		Field(func(m member) string { return m.Profile.Email }, String().Required()).
+		Validate(member{})
+	for _, field := range []string{"Nick", "Profile.Email"} {
+		if !errs.Has(field) {
+			t.Errorf("missing error for %s in:\n%s", field, errs.Format())
+		}
+	}
+}
+
+// TestFieldNameCacheConcurrent builds schemas for one type from many
+// goroutines, so the shared probe and json name caches are filled
+// concurrently; run with -race
+func TestFieldNameCacheConcurrent(t *testing.T) {
+	type fresh struct {
+		ID    string `json:"id"`
+		Inner profile
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs := Struct[fresh]().UseJSONNames().
+				Field(func(f fresh) string { return f.ID }, String().Required()).
+				Field(func(f fresh) string { return f.Inner.Email }, String().Required()).
+				Validate(fresh{})
+			for _, field := range []string{"id", "Inner.email"} {
+				if !errs.Has(field) {
+					t.Errorf("missing error for %s in:\n%s", field, errs.Format())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
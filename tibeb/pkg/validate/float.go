@@ -0,0 +1,228 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FloatValidator provides validation rules for float64 values
+type FloatValidator struct {
+	overrides
+	min       *float64
+	max       *float64
+	gt        *float64
+	lt        *float64
+	precision *int
+	positive  bool
+	negative  bool
+	finite    bool
+}
+
+var _ Validator[float64] = (*FloatValidator)(nil)
+
+// Float creates a new float validator
+func Float() *FloatValidator {
+	return &FloatValidator{}
+}
+
+// Min adds a minimum value validation rule
+func (v *FloatValidator) Min(value float64) *FloatValidator {
+	v.min = &value
+	v.rule("Min")
+	return v
+}
+
+// Max adds a maximum value validation rule
+func (v *FloatValidator) Max(value float64) *FloatValidator {
+	v.max = &value
+	v.rule("Max")
+	return v
+}
+
+// Precision limits the number of decimal places, e.g. Precision(2) for prices
+func (v *FloatValidator) Precision(maxDecimalPlaces int) *FloatValidator {
+	v.precision = &maxDecimalPlaces
+	v.rule("Precision")
+	return v
+}
+
+// Finite rejects NaN and ±Inf values
+func (v *FloatValidator) Finite() *FloatValidator {
+	v.finite = true
+	v.rule("Finite")
+	return v
+}
+
+// GreaterThan adds an exclusive minimum value validation rule
+func (v *FloatValidator) GreaterThan(value float64) *FloatValidator {
+	v.gt = &value
+	v.rule("GreaterThan")
+	return v
+}
+
+// LessThan adds an exclusive maximum value validation rule
+func (v *FloatValidator) LessThan(value float64) *FloatValidator {
+	v.lt = &value
+	v.rule("LessThan")
+	return v
+}
+
+// Positive requires the value to be positive (> 0)
+func (v *FloatValidator) Positive() *FloatValidator {
+	v.positive = true
+	v.rule("Positive")
+	return v
+}
+
+// Negative requires the value to be negative (< 0)
+func (v *FloatValidator) Negative() *FloatValidator {
+	v.negative = true
+	v.rule("Negative")
+	return v
+}
+
+// WithMessage replaces the message of the most recently added rule, e.g.
+// Precision(2).WithMessage("Use at most two decimal places")
+func (v *FloatValidator) WithMessage(message string) *FloatValidator {
+	v.setMessage(message)
+	return v
+}
+
+// WithCode replaces the error code of the most recently added rule
+func (v *FloatValidator) WithCode(code string) *FloatValidator {
+	v.setCode(code)
+	return v
+}
+
+// Compile reports rule combinations no value can satisfy, such as a
+// minimum greater than the maximum
+func (v *FloatValidator) Compile() error {
+	switch {
+	case v.min != nil && v.max != nil && *v.min > *v.max:
+		return fmt.Errorf("min %g is greater than max %g", *v.min, *v.max)
+	case v.gt != nil && v.lt != nil && *v.gt >= *v.lt:
+		return fmt.Errorf("no value is greater than %g and less than %g", *v.gt, *v.lt)
+	case v.precision != nil && *v.precision < 0:
+		return fmt.Errorf("precision %d is negative", *v.precision)
+	case v.positive && v.negative:
+		return errors.New("value cannot be both positive and negative")
+	}
+	return nil
+}
+
+// Validate implements the Validator[float64] interface
+func (v *FloatValidator) Validate(value float64) *Error {
+	if errs := v.check(value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *FloatValidator) ValidateAll(value float64) []*Error {
+	return v.check(value, true)
+}
+
+// check runs the validation rules against value
+func (v *FloatValidator) check(value float64, all bool) []*Error {
+	var errs []*Error
+	fail := func(rule string, err *Error) bool {
+		errs = append(errs, v.apply(rule, err))
+		return !all
+	}
+
+	// NaN compares false against every bound, so check it before ranges
+	if v.finite && (math.IsNaN(value) || math.IsInf(value, 0)) {
+		return []*Error{v.apply("Finite", &Error{
+			Code:    "not_finite",
+			Message: "value must be a finite number",
+			Params:  map[string]any{"actual": value},
+		})}
+	}
+
+	if v.min != nil && value < *v.min {
+		if fail("Min", &Error{
+			Code:    "too_small",
+			Message: fmt.Sprintf("value must be at least %g", *v.min),
+			Params:  map[string]any{"min": *v.min, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.max != nil && value > *v.max {
+		if fail("Max", &Error{
+			Code:    "too_large",
+			Message: fmt.Sprintf("value must be at most %g", *v.max),
+			Params:  map[string]any{"max": *v.max, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.gt != nil && value <= *v.gt {
+		if fail("GreaterThan", &Error{
+			Code:    "too_small",
+			Message: fmt.Sprintf("value must be greater than %g", *v.gt),
+			Params:  map[string]any{"gt": *v.gt, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.lt != nil && value >= *v.lt {
+		if fail("LessThan", &Error{
+			Code:    "too_large",
+			Message: fmt.Sprintf("value must be less than %g", *v.lt),
+			Params:  map[string]any{"lt": *v.lt, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.precision != nil && decimalPlaces(value) > *v.precision {
+		if fail("Precision", &Error{
+			Code:    "too_precise",
+			Message: fmt.Sprintf("value must have at most %d decimal places", *v.precision),
+			Params:  map[string]any{"precision": *v.precision, "actual": decimalPlaces(value)},
+		}) {
+			return errs
+		}
+	}
+
+	if v.positive && value <= 0 {
+		if fail("Positive", &Error{
+			Code:    "not_positive",
+			Message: "value must be positive",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.negative && value >= 0 {
+		if fail("Negative", &Error{
+			Code:    "not_negative",
+			Message: "value must be negative",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	return errs
+}
+
+// decimalPlaces returns the number of decimal places in the shortest
+// decimal representation of f that round-trips, so 19.99 counts as 2
+func decimalPlaces(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
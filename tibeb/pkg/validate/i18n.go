@@ -0,0 +1,126 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// translations maps locale to error code to message template, and locale
+// to the locale tried when it has no template for a code
+var translations = struct {
+	sync.RWMutex
+	locales   map[string]map[string]string
+	fallbacks map[string]string
+}{locales: make(map[string]map[string]string), fallbacks: make(map[string]string)}
+
+// RegisterTranslations adds message templates for locale, keyed by error
+// code, replacing any earlier template for the same code. Templates may
+// reference the error's Params and field as {name}, where {field} is the
+// field's Label when it has one, e.g.
+//
+//	validate.RegisterTranslations("am", map[string]string{
+//		"required":  "{field} ያስፈልጋል",
+//		"too_short": "ቢያንስ {min} ቁምፊዎች መሆን አለበት",
+//	})
+func RegisterTranslations(locale string, messages map[string]string) {
+	translations.Lock()
+	defer translations.Unlock()
+	templates := translations.locales[locale]
+	if templates == nil {
+		templates = make(map[string]string, len(messages))
+		translations.locales[locale] = templates
+	}
+	for code, message := range messages {
+		templates[code] = message
+	}
+}
+
+// SetFallback makes codes without a template in locale use the templates
+// of fallback, e.g. SetFallback("am", "en") so untranslated Amharic
+// messages come out in English rather than as the built-in text
+func SetFallback(locale, fallback string) {
+	translations.Lock()
+	defer translations.Unlock()
+	translations.fallbacks[locale] = fallback
+}
+
+// translation returns the template for code in locale. A locale without
+// the code falls back to the locale set with SetFallback, or else from a
+// regional locale such as "am-ET" to its language "am".
+func translation(locale, code string) (string, bool) {
+	translations.RLock()
+	defer translations.RUnlock()
+	seen := make(map[string]bool)
+	for locale != "" && !seen[locale] {
+		seen[locale] = true
+		if template, ok := translations.locales[locale][code]; ok {
+			return template, true
+		}
+		if fallback, ok := translations.fallbacks[locale]; ok {
+			locale = fallback
+			continue
+		}
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return "", false
+}
+
+// translate replaces e's message with its template for locale, if any,
+// unless the message was given explicitly
+func (e *Error) translate(locale string) {
+	if e.fixed {
+		return
+	}
+	template, ok := translation(locale, e.Code)
+	if !ok {
+		return
+	}
+	field := e.Field
+	if e.label != "" {
+		field = e.label
+	}
+	pairs := []string{"{field}", field}
+	for key, value := range e.Params {
+		pairs = append(pairs, "{"+key+"}", fmt.Sprint(value))
+	}
+	e.Message = strings.NewReplacer(pairs...).Replace(template)
+}
+
+// Translate returns a copy of the errors with their messages rendered from
+// the templates registered for locale. Errors whose code has no template,
+// and messages set with WithMessage, Message or OnError, are kept.
+func (e *Errors) Translate(locale string) *Errors {
+	translated := &Errors{}
+	for _, err := range e.errors {
+		copied := *err
+		copied.translate(locale)
+		translated.Add(&copied)
+	}
+	return translated
+}
+
+// WithLocale makes the schema render error messages from the templates
+// registered for locale. Messages set with WithMessage or Message are
+// already specific to the field and are not translated.
+func (s *Schema[T]) WithLocale(locale string) *Schema[T] {
+	s.locale = locale
+	return s
+}
+
+// localize translates errs in place when the schema has a locale
+func (s *Schema[T]) localize(errs *Errors) {
+	if s.locale == "" {
+		return
+	}
+	// translate copies, since refinements may return shared errors
+	for i, err := range errs.errors {
+		copied := *err
+		copied.translate(s.locale)
+		errs.errors[i] = &copied
+	}
+}
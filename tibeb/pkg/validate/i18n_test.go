@@ -0,0 +1,89 @@
+package validate
+
+import (
+	"sync"
+	"testing"
+)
+
+type registration struct {
+	Username string
+	Password string
+	Bio      string
+}
+
+func TestWithLocaleKeepsOverriddenMessages(t *testing.T) {
+	RegisterTranslations("xx-overrides", map[string]string{
+		"too_short": "xx too short",
+		"too_long":  "xx too long",
+	})
+	errs := Struct[registration]().WithLocale("xx-overrides").
+		Field(func(r registration) string { return r.Username }, String().MinLen(5).WithMessage("Please choose a longer username")).
+		Field(func(r registration) string { return r.Password }, String().MinLen(8)).
+		Message("too_short", "Use at least 8 characters").
+		Field(func(r registration) string { return r.Bio }, String().MaxLen(2)).
+		Validate(registration{Username: "abc", Password: "abc", Bio: "long"})
+
+	want := map[string]string{
+		"Username": "Please choose a longer username",
+		"Password": "Use at least 8 characters",
+		"Bio":      "xx too long",
+	}
+	for field, message := range want {
+		got := errs.ByField()[field]
+		if len(got) != 1 || got[0].Message != message {
+			t.Errorf("%s: got %v, want message %q", field, got, message)
+		}
+	}
+}
+
+func TestTranslateUsesLabel(t *testing.T) {
+	RegisterTranslations("xx-label", map[string]string{"required": "{field} is needed"})
+	errs := Struct[registration]().WithLocale("xx-label").
+		Field(func(r registration) string { return r.Username }, String().Required()).
+		Label("User name").
+		Field(func(r registration) string { return r.Password }, String().Required()).
+		Validate(registration{})
+
+	want := map[string]string{
+		"Username": "User name is needed",
+		"Password": "Password is needed",
+	}
+	for field, message := range want {
+		got := errs.ByField()[field]
+		if len(got) != 1 || got[0].Message != message {
+			t.Errorf("%s: got %v, want message %q", field, got, message)
+		}
+	}
+}
+
+// errRenamed is returned by every call of an update rule, like a
+// package-level sentinel error would be
+var errRenamed = &Error{Code: "renamed", Message: "username cannot change"}
+
+// TestValidateUpdateSharedError translates update errors from many
+// goroutines; run with -race. The shared error must stay unchanged.
+func TestValidateUpdateSharedError(t *testing.T) {
+	RegisterTranslations("xx-update", map[string]string{"renamed": "xx renamed"})
+	schema := Struct[registration]().WithLocale("xx-update").
+		RefineUpdate(func(old, next registration) *Error { return errRenamed })
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				errs := schema.ValidateUpdate(registration{Username: "a"}, registration{Username: "b"})
+				if first := errs.First(); first == nil || first.Message != "xx renamed" {
+					t.Errorf("ValidateUpdate = %v, want the translated message", first)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if errRenamed.Message != "username cannot change" {
+		t.Errorf("shared error was modified: %+v", errRenamed)
+	}
+}
@@ -1,13 +1,27 @@
 package validate
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math"
+)
 
 // IntValidator provides validation rules for integer values
 type IntValidator struct {
-	min      *int
-	max      *int
-	positive bool
-	negative bool
+	overrides
+	min        *int
+	max        *int
+	gt         *int
+	lt         *int
+	between    *[2]int
+	multipleOf *int
+	maxDigits  *int
+	digits     *int
+	positive   bool
+	negative   bool
+	nonZero    bool
+	even       bool
+	odd        bool
 }
 
 var _ Validator[int] = (*IntValidator)(nil)
@@ -20,56 +34,298 @@ func Int() *IntValidator {
 // Min adds a minimum value validation rule
 func (v *IntValidator) Min(value int) *IntValidator {
 	v.min = &value
+	v.rule("Min")
 	return v
 }
 
 // Max adds a maximum value validation rule
 func (v *IntValidator) Max(value int) *IntValidator {
 	v.max = &value
+	v.rule("Max")
+	return v
+}
+
+// Between requires the value to be within [lo, hi] inclusive
+func (v *IntValidator) Between(lo, hi int) *IntValidator {
+	v.between = &[2]int{lo, hi}
+	v.rule("Between")
+	return v
+}
+
+// NonZero requires the value to be non-zero
+func (v *IntValidator) NonZero() *IntValidator {
+	v.nonZero = true
+	v.rule("NonZero")
+	return v
+}
+
+// MultipleOf requires the value to be a multiple of n
+func (v *IntValidator) MultipleOf(n int) *IntValidator {
+	v.multipleOf = &n
+	v.rule("MultipleOf")
+	return v
+}
+
+// MaxDigits requires the value to have at most n decimal digits,
+// ignoring the sign
+func (v *IntValidator) MaxDigits(n int) *IntValidator {
+	v.maxDigits = &n
+	v.rule("MaxDigits")
+	return v
+}
+
+// ExactDigits requires the value to have exactly n decimal digits,
+// ignoring the sign
+func (v *IntValidator) ExactDigits(n int) *IntValidator {
+	v.digits = &n
+	v.rule("ExactDigits")
+	return v
+}
+
+// Even requires the value to be even
+func (v *IntValidator) Even() *IntValidator {
+	v.even = true
+	v.rule("Even")
+	return v
+}
+
+// Odd requires the value to be odd
+func (v *IntValidator) Odd() *IntValidator {
+	v.odd = true
+	v.rule("Odd")
+	return v
+}
+
+// GreaterThan adds an exclusive minimum value validation rule
+func (v *IntValidator) GreaterThan(value int) *IntValidator {
+	v.gt = &value
+	v.rule("GreaterThan")
+	return v
+}
+
+// LessThan adds an exclusive maximum value validation rule
+func (v *IntValidator) LessThan(value int) *IntValidator {
+	v.lt = &value
+	v.rule("LessThan")
 	return v
 }
 
 // Positive requires the value to be positive (> 0)
 func (v *IntValidator) Positive() *IntValidator {
 	v.positive = true
+	v.rule("Positive")
 	return v
 }
 
 // Negative requires the value to be negative (< 0)
 func (v *IntValidator) Negative() *IntValidator {
 	v.negative = true
+	v.rule("Negative")
+	return v
+}
+
+// WithMessage replaces the message reported by the most recently added
+// rule, e.g. Min(18).WithMessage("You must be 18 or older")
+func (v *IntValidator) WithMessage(message string) *IntValidator {
+	v.setMessage(message)
 	return v
 }
 
+// WithCode replaces the error code reported by the most recently added rule
+func (v *IntValidator) WithCode(code string) *IntValidator {
+	v.setCode(code)
+	return v
+}
+
+// Compile reports rule combinations no value can satisfy, such as a
+// minimum greater than the maximum
+func (v *IntValidator) Compile() error {
+	switch {
+	case v.min != nil && v.max != nil && *v.min > *v.max:
+		return fmt.Errorf("min %d is greater than max %d", *v.min, *v.max)
+	case v.gt != nil && *v.gt == math.MaxInt:
+		return fmt.Errorf("no integer is greater than %d", *v.gt)
+	case v.lt != nil && *v.lt == math.MinInt:
+		return fmt.Errorf("no integer is less than %d", *v.lt)
+	// lt-gt wraps around for bounds further apart than MaxInt, but it is
+	// only 1 when they are adjacent
+	case v.gt != nil && v.lt != nil && (*v.gt >= *v.lt || *v.lt-*v.gt == 1):
+		return fmt.Errorf("no integer is greater than %d and less than %d", *v.gt, *v.lt)
+	case v.between != nil && v.between[0] > v.between[1]:
+		return fmt.Errorf("between bounds %d and %d are reversed", v.between[0], v.between[1])
+	case v.multipleOf != nil && *v.multipleOf == 0:
+		return errors.New("multiple of 0 is not allowed")
+	case v.positive && v.negative:
+		return errors.New("value cannot be both positive and negative")
+	case v.even && v.odd:
+		return errors.New("value cannot be both even and odd")
+	}
+	return nil
+}
+
 // Validate implements the Validator[int] interface
 func (v *IntValidator) Validate(value int) *Error {
+	if errs := v.check(value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *IntValidator) ValidateAll(value int) []*Error {
+	return v.check(value, true)
+}
+
+// check runs the validation rules against value
+func (v *IntValidator) check(value int, all bool) []*Error {
+	var errs []*Error
+	fail := func(rule string, err *Error) bool {
+		errs = append(errs, v.apply(rule, err))
+		return !all
+	}
+
 	if v.min != nil && value < *v.min {
-		return &Error{
+		if fail("Min", &Error{
 			Code:    "too_small",
 			Message: fmt.Sprintf("value must be at least %d", *v.min),
+			Params:  map[string]any{"min": *v.min, "actual": value},
+		}) {
+			return errs
 		}
 	}
 
 	if v.max != nil && value > *v.max {
-		return &Error{
+		if fail("Max", &Error{
 			Code:    "too_large",
 			Message: fmt.Sprintf("value must be at most %d", *v.max),
+			Params:  map[string]any{"max": *v.max, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.gt != nil && value <= *v.gt {
+		if fail("GreaterThan", &Error{
+			Code:    "too_small",
+			Message: fmt.Sprintf("value must be greater than %d", *v.gt),
+			Params:  map[string]any{"gt": *v.gt, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.lt != nil && value >= *v.lt {
+		if fail("LessThan", &Error{
+			Code:    "too_large",
+			Message: fmt.Sprintf("value must be less than %d", *v.lt),
+			Params:  map[string]any{"lt": *v.lt, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.between != nil {
+		lo, hi := v.between[0], v.between[1]
+		if value < lo || value > hi {
+			if fail("Between", &Error{
+				Code:    "out_of_range",
+				Message: fmt.Sprintf("value must be between %d and %d", lo, hi),
+				Params:  map[string]any{"min": lo, "max": hi, "actual": value},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.nonZero && value == 0 {
+		if fail("NonZero", &Error{
+			Code:    "zero",
+			Message: "value must not be zero",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.multipleOf != nil && *v.multipleOf != 0 && value%*v.multipleOf != 0 {
+		if fail("MultipleOf", &Error{
+			Code:    "not_multiple",
+			Message: fmt.Sprintf("value must be a multiple of %d", *v.multipleOf),
+			Params:  map[string]any{"multiple_of": *v.multipleOf, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.maxDigits != nil && countDigits(value) > *v.maxDigits {
+		if fail("MaxDigits", &Error{
+			Code:    "too_many_digits",
+			Message: fmt.Sprintf("value must have at most %d digits", *v.maxDigits),
+			Params:  map[string]any{"max": *v.maxDigits, "actual": countDigits(value)},
+		}) {
+			return errs
+		}
+	}
+
+	if v.digits != nil && countDigits(value) != *v.digits {
+		if fail("ExactDigits", &Error{
+			Code:    "wrong_digits",
+			Message: fmt.Sprintf("value must have exactly %d digits", *v.digits),
+			Params:  map[string]any{"digits": *v.digits, "actual": countDigits(value)},
+		}) {
+			return errs
+		}
+	}
+
+	if v.even && value%2 != 0 {
+		if fail("Even", &Error{
+			Code:    "not_even",
+			Message: "value must be even",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	if v.odd && value%2 == 0 {
+		if fail("Odd", &Error{
+			Code:    "not_odd",
+			Message: "value must be odd",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
 		}
 	}
 
 	if v.positive && value <= 0 {
-		return &Error{
+		if fail("Positive", &Error{
 			Code:    "not_positive",
 			Message: "value must be positive",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
 		}
 	}
 
 	if v.negative && value >= 0 {
-		return &Error{
+		if fail("Negative", &Error{
 			Code:    "not_negative",
 			Message: "value must be negative",
+			Params:  map[string]any{"actual": value},
+		}) {
+			return errs
 		}
 	}
 
-	return nil
+	return errs
+}
+
+// countDigits returns the number of decimal digits in n, ignoring the sign
+func countDigits(n int) int {
+	digits := 1
+	for n /= 10; n != 0; n /= 10 {
+		digits++
+	}
+	return digits
 }
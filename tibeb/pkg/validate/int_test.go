@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntCompileExclusiveBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       *IntValidator
+		wantErr bool
+	}{
+		{"gap", Int().GreaterThan(1).LessThan(3), false},
+		{"adjacent", Int().GreaterThan(1).LessThan(2), true},
+		{"reversed", Int().GreaterThan(5).LessThan(2), true},
+		{"greater than max int", Int().GreaterThan(math.MaxInt), true},
+		{"greater than max int with upper bound", Int().GreaterThan(math.MaxInt).LessThan(0), true},
+		{"less than min int", Int().LessThan(math.MinInt), true},
+		{"full range", Int().GreaterThan(math.MinInt).LessThan(math.MaxInt), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.v.Compile(); (err != nil) != tt.wantErr {
+				t.Errorf("Compile() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ func (v *JSONValidator) Validate(value interface{}) *Error {
 				Field:   "",
 				Code:    "invalid_json",
 				Message: "invalid JSON format: " + err.Error(),
+				Cause:   err,
 			}
 		}
 		value = temp
@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// JSONAPIError is a JSON:API error object
+type JSONAPIError struct {
+	Status string         `json:"status"`
+	Code   string         `json:"code"`
+	Detail string         `json:"detail"`
+	Source *JSONAPISource `json:"source,omitempty"`
+	Meta   map[string]any `json:"meta,omitempty"`
+}
+
+// JSONAPISource locates the cause of a JSON:API error in the request
+// document
+type JSONAPISource struct {
+	Pointer string `json:"pointer"`
+}
+
+// JSONAPI converts the errors to JSON:API error objects with a source
+// pointer into the request's attributes, e.g. /data/attributes/address/city.
+// Errors have status 422 and warnings, which do not fail the request,
+// status 200. Marshal them under an "errors" key with the status from
+// JSONAPIStatus:
+//
+//	w.WriteHeader(errs.JSONAPIStatus())
+//	json.NewEncoder(w).Encode(map[string]any{"errors": errs.JSONAPI()})
+func (e *Errors) JSONAPI() []JSONAPIError {
+	objects := make([]JSONAPIError, 0, len(e.errors))
+	for _, err := range e.errors {
+		status := http.StatusUnprocessableEntity
+		if err.IsWarning() {
+			status = http.StatusOK
+		}
+		object := JSONAPIError{
+			Status: strconv.Itoa(status),
+			Code:   err.Code,
+			Detail: err.Message,
+			Meta:   err.Params,
+		}
+		if path := errorPath(err); len(path) > 0 {
+			object.Source = &JSONAPISource{Pointer: "/data/attributes" + path.JSONPointer()}
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// JSONAPIStatus returns the HTTP status for a response carrying the
+// errors: 422 when any error is present and 200 when there are none or
+// only warnings
+func (e *Errors) JSONAPIStatus() int {
+	if e.HasErrors() {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusOK
+}
+
+// errorPath returns err's Path, falling back to its dotted Field for errors
+// built by hand, such as those returned from Refine
+func errorPath(err *Error) Path {
+	if len(err.Path) > 0 || err.Field == "" {
+		return err.Path
+	}
+	return dottedPath(err.Field)
+}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestJSONAPIWarningsOnly(t *testing.T) {
+	errs := &Errors{}
+	errs.Add(&Error{Field: "Nickname", Code: "deprecated", Message: "is deprecated", Severity: SeverityWarning})
+
+	if status := errs.JSONAPIStatus(); status != http.StatusOK {
+		t.Errorf("JSONAPIStatus = %d, want %d", status, http.StatusOK)
+	}
+	objects := errs.JSONAPI()
+	if len(objects) != 1 || objects[0].Status != "200" {
+		t.Errorf("JSONAPI = %+v, want one object with status 200", objects)
+	}
+}
+
+func TestJSONAPIErrorsAndWarnings(t *testing.T) {
+	errs := &Errors{}
+	errs.Add(&Error{Field: "Nickname", Code: "deprecated", Message: "is deprecated", Severity: SeverityWarning})
+	errs.Add(&Error{Field: "Address.City", Code: "required", Message: "field is required"})
+
+	if status := errs.JSONAPIStatus(); status != http.StatusUnprocessableEntity {
+		t.Errorf("JSONAPIStatus = %d, want %d", status, http.StatusUnprocessableEntity)
+	}
+	objects := errs.JSONAPI()
+	if len(objects) != 2 {
+		t.Fatalf("JSONAPI returned %d objects, want 2", len(objects))
+	}
+	if objects[0].Status != "200" || objects[1].Status != "422" {
+		t.Errorf("statuses = %q, %q; want 200, 422", objects[0].Status, objects[1].Status)
+	}
+	if objects[1].Source == nil || objects[1].Source.Pointer != "/data/attributes/Address/City" {
+		t.Errorf("source = %+v, want /data/attributes/Address/City", objects[1].Source)
+	}
+}
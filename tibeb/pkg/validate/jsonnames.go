@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// UseJSONNames makes the schema report errors using the struct's json tag
+// names (e.g. email_address) instead of Go field names, so API clients can
+// map errors directly onto request payload keys. Fields without a json
+// tag, or tagged "-", keep their Go name.
+func (s *Schema[T]) UseJSONNames() *Schema[T] {
+	s.jsonNames = true
+	return s
+}
+
+// jsonNameKey identifies a field path of a type in jsonNameCache
+type jsonNameKey struct {
+	typ   reflect.Type
+	field string
+}
+
+// jsonNameCache holds the json names jsonName has resolved, so reporting
+// errors under UseJSONNames does not repeat the reflection
+var jsonNameCache sync.Map
+
+// jsonName returns the json tag name of the field of T named by a dotted
+// path. Like encoding/json, embedded structs without a json tag name are
+// flattened, so their promoted fields are reported without a prefix.
+func jsonName[T any](field string) string {
+	key := jsonNameKey{typ: reflect.TypeOf((*T)(nil)).Elem(), field: field}
+	if name, ok := jsonNameCache.Load(key); ok {
+		return name.(string)
+	}
+	name := resolveJSONName(key.typ, field)
+	jsonNameCache.Store(key, name)
+	return name
+}
+
+// resolveJSONName computes jsonName for the field of t named by path
+func resolveJSONName(t reflect.Type, field string) string {
+	fields, ok := fieldByPath(t, field)
+	if !ok {
+		return field
+	}
+	names := make([]string, 0, len(fields))
+	for i, f := range fields {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			name = ""
+		}
+		if name == "" && f.Anonymous && i < len(fields)-1 {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ".")
+}
@@ -0,0 +1,299 @@
+package validate
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// jsonSchemaDraft is the dialect emitted by Schema.JSONSchema
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaProvider is implemented by validators that can describe their
+// rules as a JSON Schema fragment. The second result reports whether the
+// value is required, which is expressed on the enclosing object.
+type jsonSchemaProvider interface {
+	jsonSchema() (map[string]any, bool)
+}
+
+// jsonSchemaOf describes v, falling back to an unconstrained schema for
+// validators that cannot describe themselves (such as Custom)
+func jsonSchemaOf(v any) (map[string]any, bool) {
+	if p, ok := v.(jsonSchemaProvider); ok {
+		return p.jsonSchema()
+	}
+	return map[string]any{}, false
+}
+
+// JSONSchema exports the schema as a draft 2020-12 JSON Schema document so
+// that frontends and contract tests can consume the same rules. Rules that
+// have no JSON Schema equivalent, such as Custom validators and Refine,
+// are omitted.
+func (s *Schema[T]) JSONSchema() ([]byte, error) {
+	doc, _ := s.jsonSchema()
+	doc["$schema"] = jsonSchemaDraft
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// jsonSchema describes the schema as an object. Fields of nested structs,
+// reported under dotted names such as Audit.CreatedBy, become properties
+// of nested object schemas.
+func (s *Schema[T]) jsonSchema() (map[string]any, bool) {
+	doc := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+	}
+	for _, rule := range s.rules {
+		name := s.reportedName(&rule)
+		prop, isRequired := jsonSchemaOf(rule.validator)
+		rule.annotate(prop)
+		isRequired = isRequired && !rule.optional && rule.when == nil && rule.requiredIf == nil
+		addJSONProperty(doc, strings.Split(name, "."), prop, isRequired)
+	}
+	return doc, false
+}
+
+// addJSONProperty adds prop at path below the object schema doc, creating
+// an object schema for every parent. A property described twice becomes
+// an allOf of both, and the parents of a required property are required.
+func addJSONProperty(doc map[string]any, path []string, prop map[string]any, required bool) {
+	properties := doc["properties"].(map[string]any)
+	name := path[0]
+	if len(path) > 1 {
+		addJSONProperty(childObject(properties, name), path[1:], prop, required)
+	} else if existing, ok := properties[name].(map[string]any); ok {
+		properties[name] = map[string]any{"allOf": []any{existing, prop}}
+	} else {
+		properties[name] = prop
+	}
+	if required {
+		list, _ := doc["required"].([]string)
+		if !containsString(list, name) {
+			doc["required"] = append(list, name)
+		}
+	}
+}
+
+// childObject returns the object schema of property name, creating it or,
+// when the property already has a schema without properties, combining
+// the two with allOf
+func childObject(properties map[string]any, name string) map[string]any {
+	existing, ok := properties[name].(map[string]any)
+	if ok {
+		if _, ok := existing["properties"].(map[string]any); ok {
+			return existing
+		}
+	}
+	child := map[string]any{"type": "object", "properties": map[string]any{}}
+	if ok {
+		properties[name] = map[string]any{"allOf": []any{existing, child}}
+	} else {
+		properties[name] = child
+	}
+	return child
+}
+
+// jsonSchema exports the length rules only when they count characters,
+// as JSON Schema's minLength and maxLength do. Without RuneLength they
+// count bytes, which only agree with characters for ASCII text.
+func (v *StringValidator) jsonSchema() (map[string]any, bool) {
+	schema := map[string]any{"type": "string"}
+	if v.required {
+		schema["minLength"] = 1
+	}
+	if v.runes || v.ascii {
+		if v.minLen != nil {
+			schema["minLength"] = *v.minLen
+		}
+		if v.maxLen != nil {
+			schema["maxLength"] = *v.maxLen
+		}
+		if v.length != nil {
+			schema["minLength"] = *v.length
+			schema["maxLength"] = *v.length
+		}
+	}
+	if v.email != nil {
+		schema["format"] = "email"
+	}
+	if v.defaultVal != nil {
+		schema["default"] = *v.defaultVal
+	}
+
+	var patterns []string
+	if v.pattern != nil {
+		patterns = append(patterns, v.pattern.String())
+	}
+	if v.prefix != nil {
+		patterns = append(patterns, "^"+regexp.QuoteMeta(*v.prefix))
+	}
+	if v.suffix != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*v.suffix)+"$")
+	}
+	if v.contains != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*v.contains))
+	}
+	if v.alpha {
+		patterns = append(patterns, `^\p{L}*$`)
+	}
+	if v.alnum {
+		patterns = append(patterns, `^[\p{L}0-9]*$`)
+	}
+	if v.numeric {
+		patterns = append(patterns, `^[0-9]*$`)
+	}
+	if v.ascii {
+		patterns = append(patterns, `^[\x00-\x7F]*$`)
+	}
+	switch len(patterns) {
+	case 0:
+	case 1:
+		schema["pattern"] = patterns[0]
+	default:
+		all := make([]any, len(patterns))
+		for i, p := range patterns {
+			all[i] = map[string]any{"pattern": p}
+		}
+		schema["allOf"] = all
+	}
+
+	return schema, v.required
+}
+
+func (v *StringLikeValidator[T]) jsonSchema() (map[string]any, bool) {
+	return jsonSchemaOf(v.validator)
+}
+
+func (v *TransformValidator[T]) jsonSchema() (map[string]any, bool) {
+	schema, required := jsonSchemaOf(v.validator)
+	if v.defaultVal != nil {
+		schema["default"] = *v.defaultVal
+	}
+	return schema, required
+}
+
+func (v *IntValidator) jsonSchema() (map[string]any, bool) {
+	schema := map[string]any{"type": "integer"}
+	if v.min != nil {
+		tighten(schema, "minimum", *v.min, true)
+	}
+	if v.max != nil {
+		tighten(schema, "maximum", *v.max, false)
+	}
+	if v.between != nil {
+		tighten(schema, "minimum", v.between[0], true)
+		tighten(schema, "maximum", v.between[1], false)
+	}
+	if v.gt != nil {
+		tighten(schema, "exclusiveMinimum", *v.gt, true)
+	}
+	if v.lt != nil {
+		tighten(schema, "exclusiveMaximum", *v.lt, false)
+	}
+	if v.positive {
+		tighten(schema, "exclusiveMinimum", 0, true)
+	}
+	if v.negative {
+		tighten(schema, "exclusiveMaximum", 0, false)
+	}
+	if v.multipleOf != nil && *v.multipleOf != 0 {
+		schema["multipleOf"] = *v.multipleOf
+	}
+	return schema, false
+}
+
+func (v *FloatValidator) jsonSchema() (map[string]any, bool) {
+	schema := map[string]any{"type": "number"}
+	if v.min != nil {
+		tighten(schema, "minimum", *v.min, true)
+	}
+	if v.max != nil {
+		tighten(schema, "maximum", *v.max, false)
+	}
+	if v.gt != nil {
+		tighten(schema, "exclusiveMinimum", *v.gt, true)
+	}
+	if v.lt != nil {
+		tighten(schema, "exclusiveMaximum", *v.lt, false)
+	}
+	if v.positive {
+		tighten(schema, "exclusiveMinimum", 0.0, true)
+	}
+	if v.negative {
+		tighten(schema, "exclusiveMaximum", 0.0, false)
+	}
+	return schema, false
+}
+
+// tighten sets the bound schema[key] unless it already holds a stricter
+// one, so overlapping rules such as GreaterThan(5) and Positive export
+// the bound that actually applies. Lower bounds keep the larger value and
+// upper bounds the smaller.
+func tighten[N int | float64](schema map[string]any, key string, bound N, lower bool) {
+	if existing, ok := schema[key].(N); ok && (lower && existing >= bound || !lower && existing <= bound) {
+		return
+	}
+	schema[key] = bound
+}
+
+func (v *TimeValidator) jsonSchema() (map[string]any, bool) {
+	return map[string]any{"type": "string", "format": "date-time"}, v.required
+}
+
+func (v *DateValidator) jsonSchema() (map[string]any, bool) {
+	schema := map[string]any{"type": "string"}
+	if v.layout == "2006-01-02" {
+		schema["format"] = "date"
+	}
+	return schema, v.required
+}
+
+func (v *EachValidator[T]) jsonSchema() (map[string]any, bool) {
+	items, _ := jsonSchemaOf(v.validator)
+	return map[string]any{"type": "array", "items": items}, false
+}
+
+func (v *NestedValidator[T]) jsonSchema() (map[string]any, bool) {
+	return v.schema.jsonSchema()
+}
+
+func (v *NestedPtrValidator[T]) jsonSchema() (map[string]any, bool) {
+	schema, _ := v.schema.jsonSchema()
+	return schema, v.required
+}
+
+func (v *MapNestedValidator[K, T]) jsonSchema() (map[string]any, bool) {
+	values, _ := v.schema.jsonSchema()
+	return map[string]any{"type": "object", "additionalProperties": values}, false
+}
+
+func (v *NamedValidator[T]) jsonSchema() (map[string]any, bool) {
+	return jsonSchemaOf(v.validator)
+}
+
+func (v *ObjectValidator) jsonSchema() (map[string]any, bool) {
+	properties := map[string]any{}
+	for _, key := range v.keys {
+		properties[key.name], _ = jsonSchemaOf(key.validator)
+	}
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(v.required) > 0 {
+		schema["required"] = v.required
+	}
+	if v.unknown == UnknownReject {
+		schema["additionalProperties"] = false
+	}
+	return schema, false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// FromJSONSchema builds an object validator from a JSON Schema document
+// whose root is an object schema, so payloads can be checked against
+// partner-defined schemas with the same engine. Supported keywords are
+// type, properties, required, additionalProperties (false only), items,
+// minItems, maxItems, minLength, maxLength, pattern, format (email and
+// date-time), minimum, maximum, exclusiveMinimum, exclusiveMaximum and
+// multipleOf, with type given as a name or a list of names such as
+// ["string", "null"]. Any other keyword, apart from annotations such as
+// title and description, is reported as an error rather than ignored.
+// Values are expected as produced by encoding/json.
+func FromJSONSchema(data []byte) (*ObjectValidator, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing JSON Schema: %w", err)
+	}
+	if t, _ := doc["type"].(string); t != "object" {
+		return nil, fmt.Errorf("JSON Schema root must be of type object, got %q", t)
+	}
+	if err := checkJSONSchemaKeywords(doc); err != nil {
+		return nil, err
+	}
+	return objectFromJSONSchema(doc)
+}
+
+func objectFromJSONSchema(doc map[string]any) (*ObjectValidator, error) {
+	v := Object()
+	properties, _ := doc["properties"].(map[string]any)
+	for _, name := range sortedKeys(properties) {
+		prop, ok := properties[name].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("property %s: schema must be an object", name)
+		}
+		rule, err := validatorFromJSONSchema(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		v.Key(name, rule)
+	}
+	if required, ok := doc["required"].([]any); ok {
+		for _, key := range required {
+			if name, ok := key.(string); ok {
+				v.Required(name)
+			}
+		}
+	}
+	if additional, ok := doc["additionalProperties"].(bool); ok && !additional {
+		v.UnknownKeys(UnknownReject)
+	}
+	return v, nil
+}
+
+// validatorFromJSONSchema converts a single JSON Schema node. A type list
+// such as ["string", "null"] accepts a value of any of the listed types.
+func validatorFromJSONSchema(doc map[string]any) (Validator[any], error) {
+	if err := checkJSONSchemaKeywords(doc); err != nil {
+		return nil, err
+	}
+	types, err := jsonSchemaTypes(doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		for key := range doc {
+			if !jsonSchemaAnnotations[key] {
+				return nil, fmt.Errorf("keyword %q requires a type", key)
+			}
+		}
+		return Custom(func(any) *Error { return nil }), nil
+	}
+	if len(types) == 1 && types[0] != "null" {
+		return validatorForJSONType(types[0], doc)
+	}
+
+	nullable := false
+	byType := make(map[string]Validator[any], len(types))
+	for _, t := range types {
+		if t == "null" {
+			nullable = true
+			continue
+		}
+		v, err := validatorForJSONType(t, doc)
+		if err != nil {
+			return nil, err
+		}
+		byType[t] = v
+	}
+	return ValidatorFunc[any](func(value any) *Error {
+		t := jsonTypeOf(value)
+		if t == "null" {
+			if nullable {
+				return nil
+			}
+			return invalidType()
+		}
+		v, ok := byType[t]
+		if !ok && t == "number" {
+			v, ok = byType["integer"]
+		}
+		if !ok {
+			return invalidType()
+		}
+		return v.Validate(value)
+	}), nil
+}
+
+// validatorForJSONType converts a JSON Schema node for one of its types
+func validatorForJSONType(t string, doc map[string]any) (Validator[any], error) {
+	switch t {
+	case "object":
+		v, err := objectFromJSONSchema(doc)
+		if err != nil {
+			return nil, err
+		}
+		return asAny[map[string]any](v), nil
+
+	case "string":
+		v := String()
+		if n, ok := jsonSchemaInt(doc, "minLength"); ok {
+			v.MinLen(n).RuneLength()
+		}
+		if n, ok := jsonSchemaInt(doc, "maxLength"); ok {
+			v.MaxLen(n).RuneLength()
+		}
+		if pattern, ok := doc["pattern"].(string); ok {
+			if _, err := v.PatternSafe(pattern); err != nil {
+				return nil, err
+			}
+		}
+		switch format := doc["format"]; format {
+		case nil:
+		case "email":
+			v.Email(EmailStrict)
+		case "date-time":
+			return asAny[string](AllOf[string](v, Date("2006-01-02T15:04:05Z07:00"))), nil
+		default:
+			return nil, fmt.Errorf("unsupported format %v", format)
+		}
+		return asAny[string](v), nil
+
+	case "number", "integer":
+		v := Float()
+		if n, ok := doc["minimum"].(float64); ok {
+			v.Min(n)
+		}
+		if n, ok := doc["maximum"].(float64); ok {
+			v.Max(n)
+		}
+		if n, ok := doc["exclusiveMinimum"].(float64); ok {
+			v.GreaterThan(n)
+		}
+		if n, ok := doc["exclusiveMaximum"].(float64); ok {
+			v.LessThan(n)
+		}
+		multipleOf, hasMultiple := doc["multipleOf"].(float64)
+		integer := t == "integer"
+		return asAny[float64](Custom(func(f float64) *Error {
+			if integer && f != math.Trunc(f) {
+				return &Error{
+					Code:    "not_integer",
+					Message: "value must be an integer",
+					Params:  map[string]any{"actual": f},
+				}
+			}
+			if hasMultiple && multipleOf != 0 && !isMultiple(f, multipleOf) {
+				return &Error{
+					Code:    "not_multiple",
+					Message: fmt.Sprintf("value must be a multiple of %g", multipleOf),
+					Params:  map[string]any{"multipleOf": multipleOf, "actual": f},
+				}
+			}
+			return v.Validate(f)
+		})), nil
+
+	case "boolean":
+		return asAny[bool](Custom(func(bool) *Error { return nil })), nil
+
+	case "array":
+		var items Validator[any] = Custom(func(any) *Error { return nil })
+		if itemDoc, ok := doc["items"].(map[string]any); ok {
+			var err error
+			if items, err = validatorFromJSONSchema(itemDoc); err != nil {
+				return nil, fmt.Errorf("items: %w", err)
+			}
+		}
+		minItems, hasMin := jsonSchemaInt(doc, "minItems")
+		maxItems, hasMax := jsonSchemaInt(doc, "maxItems")
+		each := Each(items)
+		return asAny[[]any](Custom(func(values []any) *Error {
+			if hasMin && len(values) < minItems {
+				return &Error{
+					Code:    "too_few",
+					Message: fmt.Sprintf("must have at least %d items", minItems),
+					Params:  map[string]any{"min": minItems, "actual": len(values)},
+				}
+			}
+			if hasMax && len(values) > maxItems {
+				return &Error{
+					Code:    "too_many",
+					Message: fmt.Sprintf("must have at most %d items", maxItems),
+					Params:  map[string]any{"max": maxItems, "actual": len(values)},
+				}
+			}
+			return each.Validate(values)
+		})), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type %q", t)
+	}
+}
+
+// jsonSchemaKeywords are the keywords FromJSONSchema enforces
+var jsonSchemaKeywords = map[string]bool{
+	"type": true, "properties": true, "required": true,
+	"additionalProperties": true, "items": true, "minItems": true,
+	"maxItems": true, "minLength": true, "maxLength": true, "pattern": true,
+	"format": true, "minimum": true, "maximum": true, "exclusiveMinimum": true,
+	"exclusiveMaximum": true, "multipleOf": true,
+}
+
+// jsonSchemaAnnotations are keywords that do not affect validation
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true,
+	"description": true, "default": true, "examples": true,
+}
+
+// checkJSONSchemaKeywords rejects keywords FromJSONSchema does not
+// enforce, so a document is never accepted more loosely than it reads
+func checkJSONSchemaKeywords(doc map[string]any) error {
+	for _, key := range sortedKeys(doc) {
+		if !jsonSchemaKeywords[key] && !jsonSchemaAnnotations[key] {
+			return fmt.Errorf("unsupported keyword %q", key)
+		}
+	}
+	if properties, ok := doc["properties"]; ok {
+		if _, ok := properties.(map[string]any); !ok {
+			return fmt.Errorf("properties must be an object")
+		}
+	}
+	if additional, ok := doc["additionalProperties"]; ok {
+		if _, ok := additional.(bool); !ok {
+			return fmt.Errorf("only boolean additionalProperties are supported")
+		}
+	}
+	if items, ok := doc["items"]; ok {
+		if _, ok := items.(map[string]any); !ok {
+			return fmt.Errorf("items must be a single schema")
+		}
+	}
+	return nil
+}
+
+// jsonSchemaTypes returns the node's type keyword as a list, or nil when
+// it has none
+func jsonSchemaTypes(doc map[string]any) ([]string, error) {
+	switch t := doc["type"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{t}, nil
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, item := range t {
+			name, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("type list must contain strings, got %v", item)
+			}
+			types = append(types, name)
+		}
+		if len(types) == 0 {
+			return nil, fmt.Errorf("type list must not be empty")
+		}
+		return types, nil
+	default:
+		return nil, fmt.Errorf("type must be a string or a list of strings, got %v", t)
+	}
+}
+
+// jsonTypeOf returns the JSON Schema type of a value decoded by
+// encoding/json, reporting every number as "number"
+func jsonTypeOf(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return ""
+	}
+}
+
+// isMultiple reports whether f is a multiple of step, allowing for the
+// rounding of decimal steps such as 0.01 in binary floating point
+func isMultiple(f, step float64) bool {
+	q := f / step
+	return math.Abs(q-math.Round(q)) <= 1e-9*math.Max(1, math.Abs(q))
+}
+
+// asAny adapts a typed validator to values of unknown type, failing with
+// invalid_type when the value is not a T
+func asAny[T any](v Validator[T]) Validator[any] {
+	return ValidatorFunc[any](func(value any) *Error {
+		typed, ok := value.(T)
+		if !ok {
+			return invalidType()
+		}
+		return v.Validate(typed)
+	})
+}
+
+func jsonSchemaInt(doc map[string]any, key string) (int, bool) {
+	f, ok := doc[key].(float64)
+	return int(f), ok
+}
+
+// sortedKeys returns the keys of m in sorted order so validators are built
+// and errors reported deterministically
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
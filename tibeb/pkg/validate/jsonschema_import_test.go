@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+const partnerSchema = `{
+	"type": "object",
+	"title": "order",
+	"properties": {
+		"id":       {"type": "string", "minLength": 2, "pattern": "^o-"},
+		"note":     {"type": ["string", "null"], "maxLength": 5},
+		"price":    {"type": "number", "minimum": 0, "multipleOf": 0.01},
+		"quantity": {"type": "integer", "exclusiveMinimum": 0},
+		"placed":   {"type": "string", "format": "date-time", "pattern": "Z$"},
+		"tags":     {"type": "array", "items": {"type": "string"}, "maxItems": 2}
+	},
+	"required": ["id"],
+	"additionalProperties": false
+}`
+
+func TestFromJSONSchema(t *testing.T) {
+	v, err := FromJSONSchema([]byte(partnerSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		payload map[string]any
+		// want is the field and code of the expected error, or "" for none
+		want string
+	}{
+		{"valid", map[string]any{"id": "o-1", "note": nil, "price": 19.99, "quantity": 2.0, "placed": "2024-01-02T03:04:05Z", "tags": []any{"a"}}, ""},
+		{"decimal multiple", map[string]any{"id": "o-1", "price": 0.3}, ""},
+		{"not a multiple", map[string]any{"id": "o-1", "price": 0.125}, "price not_multiple"},
+		{"missing required", map[string]any{}, "id required"},
+		{"unknown key", map[string]any{"id": "o-1", "extra": 1.0}, "extra unknown_key"},
+		{"pattern", map[string]any{"id": "x-1"}, "id invalid_format"},
+		{"null not allowed", map[string]any{"id": nil}, "id invalid_type"},
+		{"nullable too long", map[string]any{"id": "o-1", "note": "too long"}, "note too_long"},
+		{"nullable wrong type", map[string]any{"id": "o-1", "note": 1.0}, "note invalid_type"},
+		{"not an integer", map[string]any{"id": "o-1", "quantity": 1.5}, "quantity not_integer"},
+		{"date-time keeps pattern", map[string]any{"id": "o-1", "placed": "2024-01-02T03:04:05+01:00"}, "placed invalid_format"},
+		{"bad date-time", map[string]any{"id": "o-1", "placed": "yesterday Z"}, "placed invalid_date"},
+		{"too many items", map[string]any{"id": "o-1", "tags": []any{"a", "b", "c"}}, "tags too_many"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := v.ValidateAll(tt.payload)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Field+" "+err.Code)
+			}
+			if strings.Join(got, ", ") != tt.want {
+				t.Errorf("errors = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromJSONSchemaRejects(t *testing.T) {
+	tests := map[string]string{
+		"unsupported keyword": `{"type": "object", "properties": {"a": {"type": "string", "enum": ["x"]}}}`,
+		"untyped constraint":  `{"type": "object", "properties": {"a": {"minLength": 1}}}`,
+		"unknown format":      `{"type": "object", "properties": {"a": {"type": "string", "format": "uri"}}}`,
+		"unknown type":        `{"type": "object", "properties": {"a": {"type": "decimal"}}}`,
+		"non-object root":     `{"type": "string"}`,
+		"invalid pattern":     `{"type": "object", "properties": {"a": {"type": "string", "pattern": "("}}}`,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := FromJSONSchema([]byte(doc)); err == nil {
+				t.Error("FromJSONSchema succeeded, want an error")
+			}
+		})
+	}
+}
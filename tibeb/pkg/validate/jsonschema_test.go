@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type audit struct {
+	CreatedBy string
+	Note      string
+}
+
+type document struct {
+	Title string
+	Audit audit
+}
+
+func TestJSONSchemaNestedFields(t *testing.T) {
+	schema := Struct[document]().
+		Field(func(d document) string { return d.Title }, String().Required()).
+		Field(func(d document) string { return d.Audit.CreatedBy }, String().Required()).
+		Field(func(d document) string { return d.Audit.Note }, String().MaxLen(10))
+	data, err := schema.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Properties map[string]struct {
+			Type       string                    `json:"type"`
+			Properties map[string]map[string]any `json:"properties"`
+			Required   []string                  `json:"required"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := doc.Properties["Audit.CreatedBy"]; ok {
+		t.Error("nested field exported as a flat dotted property")
+	}
+	nested := doc.Properties["Audit"]
+	if nested.Type != "object" || nested.Properties["CreatedBy"] == nil || nested.Properties["Note"] == nil {
+		t.Errorf("Audit = %+v, want an object with CreatedBy and Note", nested)
+	}
+	if len(nested.Required) != 1 || nested.Required[0] != "CreatedBy" {
+		t.Errorf("Audit required = %v, want [CreatedBy]", nested.Required)
+	}
+	if len(doc.Required) != 2 || doc.Required[0] != "Title" || doc.Required[1] != "Audit" {
+		t.Errorf("required = %v, want [Title Audit]", doc.Required)
+	}
+}
+
+type measurement struct {
+	Count int
+	Ratio float64
+}
+
+func TestJSONSchemaKeepsStricterBounds(t *testing.T) {
+	schema := Struct[measurement]().
+		Field(func(m measurement) int { return m.Count }, Int().GreaterThan(5).Positive().Max(10).Between(0, 20)).
+		Field(func(m measurement) float64 { return m.Ratio }, Float().LessThan(-1).Negative())
+	data, err := schema.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw struct {
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]map[string]any{
+		"Count": {"exclusiveMinimum": 5.0, "minimum": 0.0, "maximum": 10.0},
+		"Ratio": {"exclusiveMaximum": -1.0},
+	}
+	for field, bounds := range want {
+		for key, value := range bounds {
+			if got := raw.Properties[field][key]; got != value {
+				t.Errorf("%s %s = %v, want %v", field, key, got, value)
+			}
+		}
+	}
+}
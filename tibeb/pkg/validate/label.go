@@ -0,0 +1,25 @@
+package validate
+
+import "strings"
+
+// Label sets a human-readable name for the most recently added field, used
+// at the start of its error messages instead of the generic subject, e.g.
+// "Email address must be a valid email address"
+func (s *Schema[T]) Label(label string) *Schema[T] {
+	s.lastRule().label = label
+	return s
+}
+
+// labelMessage rewrites a built-in message such as "field is required" or
+// "must be at least 3 characters" so that it starts with label
+func labelMessage(label, message string) string {
+	for _, subject := range []string{"field ", "value ", "time "} {
+		if strings.HasPrefix(message, subject) {
+			return label + " " + message[len(subject):]
+		}
+	}
+	if strings.HasPrefix(message, "must ") {
+		return label + " " + message
+	}
+	return label + ": " + message
+}
@@ -0,0 +1,130 @@
+package validate
+
+import (
+	"context"
+	"sync"
+)
+
+// LazyValidator validates values against a schema that is built on first
+// use, so recursive and mutually recursive types can refer to schemas that
+// are not finished yet
+type LazyValidator[T any] struct {
+	build  func() *Schema[T]
+	once   sync.Once
+	nested *NestedValidator[T]
+}
+
+var _ ContextValidator[struct{}] = (*LazyValidator[struct{}])(nil)
+
+// Lazy creates a validator that obtains its schema from fn the first time
+// it validates a value, e.g. for a tree of categories:
+//
+//	var categorySchema *validate.Schema[Category]
+//	categorySchema = validate.Struct[Category]().
+//		Field(func(c Category) []Category { return c.Children },
+//			validate.Each[Category](validate.Lazy(func() *validate.Schema[Category] { return categorySchema })))
+func Lazy[T any](fn func() *Schema[T]) *LazyValidator[T] {
+	return &LazyValidator[T]{
+		build: fn,
+	}
+}
+
+// resolve builds the schema once and returns the validator for it
+func (v *LazyValidator[T]) resolve() *NestedValidator[T] {
+	v.once.Do(func() {
+		v.nested = &NestedValidator[T]{schema: v.build()}
+	})
+	return v.nested
+}
+
+// Compile always succeeds; the referenced schema is compiled where it is
+// defined, which also keeps Compile from recursing forever
+func (v *LazyValidator[T]) Compile() error {
+	return nil
+}
+
+// Validate implements the Validator interface
+func (v *LazyValidator[T]) Validate(value T) *Error {
+	return v.resolve().Validate(value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *LazyValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return v.resolve().ValidateContext(ctx, value)
+}
+
+// ValidateAll returns every error of the schema
+func (v *LazyValidator[T]) ValidateAll(value T) []*Error {
+	return v.resolve().ValidateAll(value)
+}
+
+// ValidateAllContext returns every error of the schema, passing ctx to it
+func (v *LazyValidator[T]) ValidateAllContext(ctx context.Context, value T) []*Error {
+	return v.resolve().ValidateAllContext(ctx, value)
+}
+
+// collectsAll reports every error of the schema, like Nested
+func (v *LazyValidator[T]) collectsAll() bool {
+	return true
+}
+
+// DeferredValidator builds its validator on first use
+type DeferredValidator[T any] struct {
+	build     func() Validator[T]
+	once      sync.Once
+	validator Validator[T]
+}
+
+var _ ContextValidator[string] = (*DeferredValidator[string])(nil)
+
+// Defer creates a validator that obtains the validator to run from fn the
+// first time it is used, so expensive setup such as loading a wordlist
+// or compiling a large pattern only happens for schemas that actually run:
+//
+//	var sku = validate.Defer(func() validate.Validator[string] {
+//		return validate.String().Pattern(loadCatalogPattern())
+//	})
+//
+// fn is called at most once, even under concurrent use
+func Defer[T any](fn func() Validator[T]) *DeferredValidator[T] {
+	return &DeferredValidator[T]{
+		build: fn,
+	}
+}
+
+// resolve builds the validator once and returns it
+func (v *DeferredValidator[T]) resolve() Validator[T] {
+	v.once.Do(func() {
+		v.validator = v.build()
+	})
+	return v.validator
+}
+
+// Compile builds the validator and reports its misconfiguration
+func (v *DeferredValidator[T]) Compile() error {
+	return compileValidator(v.resolve())
+}
+
+// Validate implements the Validator interface
+func (v *DeferredValidator[T]) Validate(value T) *Error {
+	return v.resolve().Validate(value)
+}
+
+// ValidateAll returns every error of the built validator
+func (v *DeferredValidator[T]) ValidateAll(value T) []*Error {
+	return validateAll(v.resolve(), value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *DeferredValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return validateContext(ctx, v.resolve(), value)
+}
+
+// Parse returns the parsed value when the built validator implements
+// Parser and value unchanged otherwise
+func (v *DeferredValidator[T]) Parse(value T) (T, *Error) {
+	if parser, ok := v.resolve().(Parser[T]); ok {
+		return parser.Parse(value)
+	}
+	return value, v.Validate(value)
+}
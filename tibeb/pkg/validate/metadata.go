@@ -0,0 +1,84 @@
+package validate
+
+import "maps"
+
+// FieldInfo describes a field rule of a schema for introspection, such as
+// generating API documentation
+type FieldInfo struct {
+	// Name is the field name errors are reported under
+	Name string
+	// Label is the display name set by Label
+	Label string
+	// Description is the text set by Describe
+	Description string
+	// Examples are the values added by Example
+	Examples []any
+	// Metadata holds the key/values set by Meta
+	Metadata map[string]any
+	// Validator is the validator the field was added with
+	Validator any
+}
+
+// Describe sets a description for the most recently added field; it is
+// exported as the JSON Schema description and reported by Fields
+func (s *Schema[T]) Describe(description string) *Schema[T] {
+	s.lastRule().description = description
+	return s
+}
+
+// Example adds an example value for the most recently added field; examples
+// are exported as JSON Schema examples and reported by Fields
+func (s *Schema[T]) Example(value any) *Schema[T] {
+	rule := s.lastRule()
+	rule.examples = append(rule.examples[:len(rule.examples):len(rule.examples)], value)
+	return s
+}
+
+// Meta attaches an arbitrary key/value to the most recently added field. It
+// is reported by Fields and exported in JSON Schema as an "x-" prefixed
+// keyword, e.g. Meta("owner", "billing") becomes "x-owner": "billing".
+func (s *Schema[T]) Meta(key string, value any) *Schema[T] {
+	rule := s.lastRule()
+	// copy so that schemas derived from a common base never share metadata
+	meta := maps.Clone(rule.meta)
+	if meta == nil {
+		meta = make(map[string]any)
+	}
+	meta[key] = value
+	rule.meta = meta
+	return s
+}
+
+// Fields describes every field rule of the schema in order
+func (s *Schema[T]) Fields() []FieldInfo {
+	fields := make([]FieldInfo, 0, len(s.rules))
+	for i := range s.rules {
+		rule := &s.rules[i]
+		fields = append(fields, FieldInfo{
+			Name:        s.reportedName(rule),
+			Label:       rule.label,
+			Description: rule.description,
+			Examples:    append([]any(nil), rule.examples...),
+			Metadata:    maps.Clone(rule.meta),
+			Validator:   rule.validator,
+		})
+	}
+	return fields
+}
+
+// annotate adds the rule's label, description, examples and metadata to a
+// JSON Schema property
+func (rule *FieldRule[T]) annotate(prop map[string]any) {
+	if rule.label != "" {
+		prop["title"] = rule.label
+	}
+	if rule.description != "" {
+		prop["description"] = rule.description
+	}
+	if len(rule.examples) > 0 {
+		prop["examples"] = rule.examples
+	}
+	for key, value := range rule.meta {
+		prop["x-"+key] = value
+	}
+}
@@ -0,0 +1,66 @@
+package validate
+
+import "context"
+
+// NamedValidator reports the errors of a standalone validator under a field
+// name, for values validated outside a struct schema such as query
+// parameters or command-line arguments
+type NamedValidator[T any] struct {
+	name      string
+	validator Validator[T]
+}
+
+var _ ContextValidator[string] = (*NamedValidator[string])(nil)
+
+// Named wraps validator so that its errors carry name as their Field, e.g.
+//
+//	err := validate.Named("email", validate.String().Email()).Validate(r.URL.Query().Get("email"))
+//	// err.Field == "email"
+func Named[T any](name string, validator Validator[T]) *NamedValidator[T] {
+	return &NamedValidator[T]{
+		name:      name,
+		validator: validator,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *NamedValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *NamedValidator[T]) Validate(value T) *Error {
+	return v.attach(v.validator.Validate(value))
+}
+
+// ValidateAll returns every error of the wrapped validator
+func (v *NamedValidator[T]) ValidateAll(value T) []*Error {
+	errs := validateAll(v.validator, value)
+	for i, err := range errs {
+		errs[i] = v.attach(err)
+	}
+	return errs
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *NamedValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return v.attach(validateContext(ctx, v.validator, value))
+}
+
+// Parse returns the parsed value when the wrapped validator implements
+// Parser and value unchanged otherwise
+func (v *NamedValidator[T]) Parse(value T) (T, *Error) {
+	if parser, ok := v.validator.(Parser[T]); ok {
+		parsed, err := parser.Parse(value)
+		return parsed, v.attach(err)
+	}
+	return value, v.Validate(value)
+}
+
+// attach returns a copy of err prefixed with the validator's name
+func (v *NamedValidator[T]) attach(err *Error) *Error {
+	if err == nil {
+		return nil
+	}
+	return err.withPrefix(FieldSegment(v.name))
+}
@@ -0,0 +1,20 @@
+package validate
+
+import "testing"
+
+func TestNamedSetsField(t *testing.T) {
+	named := Named("email", String().MinLen(10).Email())
+
+	if err := named.Validate("x"); err == nil || err.Field != "email" {
+		t.Errorf("Validate = %v, want an error for field email", err)
+	}
+	errs := named.ValidateAll("x")
+	if len(errs) != 2 {
+		t.Fatalf("ValidateAll returned %d errors, want 2: %v", len(errs), errs)
+	}
+	for _, err := range errs {
+		if err.Field != "email" {
+			t.Errorf("ValidateAll error %v has field %q, want email", err, err.Field)
+		}
+	}
+}
@@ -1,6 +1,16 @@
 package validate
 
-// NestedValidator provides validation for nested structs
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// NestedValidator provides validation for nested structs. In a schema it
+// reports every error of the nested schema, each prefixed with the parent
+// field, so Address.City and Address.Zip are both reported.
 type NestedValidator[T any] struct {
 	schema *Schema[T]
 }
@@ -12,16 +22,301 @@ func Nested[T any](schema *Schema[T]) Validator[T] {
 	}
 }
 
-// Validate implements the Validator interface
+// Compile reports misconfiguration of the nested schema
+func (v *NestedValidator[T]) Compile() error {
+	return v.schema.Compile()
+}
+
+// Validate implements the Validator interface, returning the first error
+// of the nested schema other than warnings
 func (v *NestedValidator[T]) Validate(value T) *Error {
-	if errs := v.schema.Validate(value); errs.HasErrors() {
-		// Return the first error with the proper field path
-		firstErr := errs.Get()[0]
-		return &Error{
-			Code:    firstErr.Code,
-			Message: firstErr.Message,
-			Field:   firstErr.Field,
+	return firstFailure(v.schema.Validate(value))
+}
+
+// ValidateAll returns every error of the nested schema, including warnings
+func (v *NestedValidator[T]) ValidateAll(value T) []*Error {
+	return childErrors(v.schema.Validate(value))
+}
+
+// collectsAll makes schemas report every nested error, leaving how many
+// the nested schema finds to its own CollectAll setting
+func (v *NestedValidator[T]) collectsAll() bool {
+	return true
+}
+
+// firstFailure returns a copy of the first error of errs other than
+// warnings, keeping its path so a parent can prefix it, or nil
+func firstFailure(errs *Errors) *Error {
+	if !errs.HasErrors() {
+		return nil
+	}
+	return childError(errs.failures()[0])
+}
+
+// childErrors returns copies of errs with their paths kept
+func childErrors(errs *Errors) []*Error {
+	var children []*Error
+	for _, err := range errs.Get() {
+		children = append(children, childError(err))
+	}
+	return children
+}
+
+// childError copies err, filling in Path from Field for errors built by
+// hand so that prefixing keeps the nested location
+func childError(err *Error) *Error {
+	child := *err
+	child.Path = errorPath(err)
+	return &child
+}
+
+// NestedPtrValidator validates pointer sub-structs with a schema for the
+// type they point to
+type NestedPtrValidator[T any] struct {
+	schema   *Schema[T]
+	required bool
+}
+
+var _ MultiContextValidator[*struct{}] = (*NestedPtrValidator[struct{}])(nil)
+
+// NestedPtr creates a validator for *T fields that validates the pointed-to
+// struct with schema. A nil pointer passes unless Required is set.
+func NestedPtr[T any](schema *Schema[T]) *NestedPtrValidator[T] {
+	return &NestedPtrValidator[T]{
+		schema: schema,
+	}
+}
+
+// Required reports a nil pointer as a required error
+func (v *NestedPtrValidator[T]) Required() *NestedPtrValidator[T] {
+	v.required = true
+	return v
+}
+
+// AllowNil lets a nil pointer pass, which is the default
+func (v *NestedPtrValidator[T]) AllowNil() *NestedPtrValidator[T] {
+	v.required = false
+	return v
+}
+
+// Compile reports misconfiguration of the nested schema
+func (v *NestedPtrValidator[T]) Compile() error {
+	return v.schema.Compile()
+}
+
+// Validate implements the Validator interface
+func (v *NestedPtrValidator[T]) Validate(value *T) *Error {
+	if value == nil {
+		return v.nilError()
+	}
+	return firstFailure(v.schema.Validate(*value))
+}
+
+// ValidateAll returns every error of the nested schema
+func (v *NestedPtrValidator[T]) ValidateAll(value *T) []*Error {
+	if value == nil {
+		return v.nilErrors()
+	}
+	return childErrors(v.schema.Validate(*value))
+}
+
+// collectsAll makes schemas report every nested error
+func (v *NestedPtrValidator[T]) collectsAll() bool {
+	return true
+}
+
+// nilError returns the error for a nil pointer, if any
+func (v *NestedPtrValidator[T]) nilError() *Error {
+	if !v.required {
+		return nil
+	}
+	return &Error{
+		Code:    "required",
+		Message: "field is required",
+	}
+}
+
+// nilErrors returns nilError as a slice
+func (v *NestedPtrValidator[T]) nilErrors() []*Error {
+	if err := v.nilError(); err != nil {
+		return []*Error{err}
+	}
+	return nil
+}
+
+// MapNestedValidator validates every value of a map with a schema
+type MapNestedValidator[K comparable, T any] struct {
+	schema *Schema[T]
+}
+
+var _ MultiContextValidator[map[string]struct{}] = (*MapNestedValidator[string, struct{}])(nil)
+
+// MapNested creates a validator for map[K]T fields, such as named
+// configuration sections, that validates every value with schema and
+// reports errors under the map key, e.g. Backends["eu"].URL. Keys are
+// visited in the order of their fmt.Sprint form so errors are stable.
+func MapNested[K comparable, T any](schema *Schema[T]) *MapNestedValidator[K, T] {
+	return &MapNestedValidator[K, T]{
+		schema: schema,
+	}
+}
+
+// Compile reports misconfiguration of the nested schema
+func (v *MapNestedValidator[K, T]) Compile() error {
+	return v.schema.Compile()
+}
+
+// Validate implements the Validator interface, returning the first error
+// in key order
+func (v *MapNestedValidator[K, T]) Validate(values map[K]T) *Error {
+	for _, key := range orderedKeys(values) {
+		if err := firstFailure(v.schema.Validate(values[key.key])); err != nil {
+			return err.withPrefix(KeySegment(key.name))
+		}
+	}
+	return nil
+}
+
+// ValidateAll returns the errors of every invalid value
+func (v *MapNestedValidator[K, T]) ValidateAll(values map[K]T) []*Error {
+	var errs []*Error
+	for _, key := range orderedKeys(values) {
+		for _, err := range childErrors(v.schema.Validate(values[key.key])) {
+			errs = append(errs, err.withPrefix(KeySegment(key.name)))
+		}
+	}
+	return errs
+}
+
+// collectsAll makes schemas report every nested error
+func (v *MapNestedValidator[K, T]) collectsAll() bool {
+	return true
+}
+
+// mapKey pairs a map key with its rendering in error paths
+type mapKey[K comparable] struct {
+	key  K
+	name string
+}
+
+// orderedKeys returns the keys of values ordered by their fmt.Sprint form
+func orderedKeys[K comparable, T any](values map[K]T) []mapKey[K] {
+	keys := make([]mapKey[K], 0, len(values))
+	for key := range values {
+		keys = append(keys, mapKey[K]{key: key, name: fmt.Sprint(key)})
+	}
+	slices.SortFunc(keys, func(a, b mapKey[K]) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return keys
+}
+
+// NestedAnyValidator validates interface-typed values with the schema
+// registered for their dynamic type
+type NestedAnyValidator struct {
+	cases map[reflect.Type]func(context.Context, any) *Errors
+	types []reflect.Type
+	// compilers holds the Compile method of each registered schema
+	compilers []func() error
+}
+
+var _ MultiContextValidator[any] = (*NestedAnyValidator)(nil)
+
+// NestedAny creates a validator for interface-typed fields, such as the
+// payload of an event envelope, that dispatches on the dynamic type of the
+// value. Register a schema per concrete type with On. A nil value passes;
+// a value of an unregistered type is reported as invalid_type.
+func NestedAny() *NestedAnyValidator {
+	return &NestedAnyValidator{
+		cases: make(map[reflect.Type]func(context.Context, any) *Errors),
+	}
+}
+
+// On registers schema for values of dynamic type T, or *T, and returns v
+// for chaining. It is a function rather than a method because Go methods
+// cannot have type parameters:
+//
+//	payload := validate.On(validate.On(validate.NestedAny(),
+//		userCreatedSchema),
+//		orderPlacedSchema)
+func On[T any](v *NestedAnyValidator, schema *Schema[T]) *NestedAnyValidator {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if _, ok := v.cases[typ]; !ok {
+		v.types = append(v.types, typ)
+		v.compilers = append(v.compilers, schema.Compile)
+	}
+	v.cases[typ] = func(ctx context.Context, value any) *Errors {
+		return schema.ValidateContext(ctx, value.(T))
+	}
+	return v
+}
+
+// Compile reports misconfiguration of the registered schemas
+func (v *NestedAnyValidator) Compile() error {
+	for i, compile := range v.compilers {
+		if err := compile(); err != nil {
+			return fmt.Errorf("%s: %w", v.types[i], err)
 		}
 	}
 	return nil
 }
+
+// Validate implements the Validator interface
+func (v *NestedAnyValidator) Validate(value any) *Error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+// ValidateAll returns every error of the schema for the value's type
+func (v *NestedAnyValidator) ValidateAll(value any) []*Error {
+	return v.ValidateAllContext(context.Background(), value)
+}
+
+// ValidateContext validates value with the schema for its type, passing
+// ctx to it
+func (v *NestedAnyValidator) ValidateContext(ctx context.Context, value any) *Error {
+	errs, err := v.dispatch(ctx, value)
+	if err != nil {
+		return err
+	}
+	return firstFailure(errs)
+}
+
+// ValidateAllContext returns every error of the schema for the value's
+// type, passing ctx to it
+func (v *NestedAnyValidator) ValidateAllContext(ctx context.Context, value any) []*Error {
+	errs, err := v.dispatch(ctx, value)
+	if err != nil {
+		return []*Error{err}
+	}
+	return childErrors(errs)
+}
+
+// collectsAll makes schemas report every nested error
+func (v *NestedAnyValidator) collectsAll() bool {
+	return true
+}
+
+// dispatch runs the schema registered for the dynamic type of value,
+// dereferencing pointers to registered types
+func (v *NestedAnyValidator) dispatch(ctx context.Context, value any) (*Errors, *Error) {
+	if value == nil {
+		return &Errors{}, nil
+	}
+	if validate, ok := v.cases[reflect.TypeOf(value)]; ok {
+		return validate(ctx, value), nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return &Errors{}, nil
+		}
+		if validate, ok := v.cases[rv.Type().Elem()]; ok {
+			return validate(ctx, rv.Elem().Interface()), nil
+		}
+	}
+	return nil, &Error{
+		Code:    "invalid_type",
+		Message: fmt.Sprintf("unsupported type %T", value),
+		Params:  map[string]any{"actual": fmt.Sprintf("%T", value)},
+	}
+}
@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"reflect"
+	"runtime"
+	"time"
+)
+
+// nilPolicy decides what happens to a rule whose field is behind a nil
+// pointer
+type nilPolicy int
+
+const (
+	// nilDefault reports a field behind a nil pointer the selector
+	// dereferences as required, so Required rules cannot be bypassed, and
+	// passes nil pointers returned by the selector to the validator
+	nilDefault nilPolicy = iota
+	// nilSkip skips the rule whenever the field is nil
+	nilSkip
+	// nilRequired reports a nil field as required
+	nilRequired
+)
+
+// SkipIfNil changes the most recently added field rule so that it is
+// skipped when its selector returns a nil pointer or dereferences one on
+// the way to the field, e.g. u.Address.City with a nil Address. Use it for
+// optional sub-objects; by default such a field is reported as required.
+func (s *Schema[T]) SkipIfNil() *Schema[T] {
+	s.lastRule().nilPolicy = nilSkip
+	return s
+}
+
+// RequiredPresent changes the most recently added field rule so that a nil
+// pointer returned or dereferenced by its selector is reported as required
+func (s *Schema[T]) RequiredPresent() *Schema[T] {
+	s.lastRule().nilPolicy = nilRequired
+	return s
+}
+
+// selectField calls the rule's selector, reporting false instead of
+// panicking when the selector dereferences a nil pointer
+func selectField[T any](selector func(T) any, value T) (result any, ok bool) {
+	return selectValue(selector, value)
+}
+
+// selectValue is selectField for selectors of any result type
+func selectValue[T, F any](selector func(T) F, value T) (result F, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if !isNilDereference(r) {
+				panic(r)
+			}
+			ok = false
+		}
+	}()
+	return selector(value), true
+}
+
+// nilDereferenceMessage is the message of the runtime error raised by
+// dereferencing a nil pointer, captured once from the runtime itself
+var nilDereferenceMessage = func() (message string) {
+	defer func() {
+		if err, ok := recover().(runtime.Error); ok {
+			message = err.Error()
+		}
+	}()
+	var p *struct{ x int }
+	_ = p.x
+	return ""
+}()
+
+// isNilDereference reports whether a recovered panic value is the runtime
+// error raised by dereferencing a nil pointer
+func isNilDereference(r any) bool {
+	err, ok := r.(runtime.Error)
+	return ok && err.Error() == nilDereferenceMessage
+}
+
+// isNilPointer reports whether v is a nil pointer
+func isNilPointer(v any) bool {
+	switch v.(type) {
+	case string, int, int64, float64, bool, time.Time:
+		return false
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
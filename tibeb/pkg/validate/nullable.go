@@ -0,0 +1,146 @@
+package validate
+
+import (
+	"context"
+	"database/sql"
+)
+
+// NullableValidator validates the value a pointer refers to, passing nil
+type NullableValidator[T any] struct {
+	validator Validator[T]
+}
+
+var _ ContextValidator[*string] = (*NullableValidator[string])(nil)
+
+// Nullable wraps validator for pointer values: nil passes and any other
+// pointer is dereferenced and validated, e.g. for optional columns
+//
+//	validate.Nullable[string](validate.String().Email()).Validate(user.BackupEmail)
+func Nullable[T any](validator Validator[T]) *NullableValidator[T] {
+	return &NullableValidator[T]{
+		validator: validator,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *NullableValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *NullableValidator[T]) Validate(value *T) *Error {
+	if value == nil {
+		return nil
+	}
+	return v.validator.Validate(*value)
+}
+
+// ValidateAll returns every error of the wrapped validator
+func (v *NullableValidator[T]) ValidateAll(value *T) []*Error {
+	if value == nil {
+		return nil
+	}
+	return validateAll(v.validator, *value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *NullableValidator[T]) ValidateContext(ctx context.Context, value *T) *Error {
+	if value == nil {
+		return nil
+	}
+	return validateContext(ctx, v.validator, *value)
+}
+
+// NullableSQLValidator validates the value held by a sql.Null, passing
+// values that are not Valid
+type NullableSQLValidator[T any] struct {
+	validator Validator[T]
+}
+
+var _ ContextValidator[sql.Null[string]] = (*NullableSQLValidator[string])(nil)
+
+// NullableSQL wraps validator for sql.Null values: NULL passes and a
+// Valid value is validated, e.g.
+//
+//	validate.NullableSQL[string](validate.String().MaxLen(64)).Validate(row.Nickname)
+func NullableSQL[T any](validator Validator[T]) *NullableSQLValidator[T] {
+	return &NullableSQLValidator[T]{
+		validator: validator,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *NullableSQLValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *NullableSQLValidator[T]) Validate(value sql.Null[T]) *Error {
+	if !value.Valid {
+		return nil
+	}
+	return v.validator.Validate(value.V)
+}
+
+// ValidateAll returns every error of the wrapped validator
+func (v *NullableSQLValidator[T]) ValidateAll(value sql.Null[T]) []*Error {
+	if !value.Valid {
+		return nil
+	}
+	return validateAll(v.validator, value.V)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *NullableSQLValidator[T]) ValidateContext(ctx context.Context, value sql.Null[T]) *Error {
+	if !value.Valid {
+		return nil
+	}
+	return validateContext(ctx, v.validator, value.V)
+}
+
+// OptionalValidator skips validation of zero values
+type OptionalValidator[T any] struct {
+	validator Validator[T]
+}
+
+var _ ContextValidator[int] = (*OptionalValidator[int])(nil)
+
+// Optional wraps validator so that the zero value of T passes without
+// being validated, giving ints, times and slices the skip-if-empty
+// behaviour of StringValidator.Optional, e.g.
+//
+//	validate.Optional[int](validate.Int().Between(1, 100)) // 0 means unset
+func Optional[T any](validator Validator[T]) *OptionalValidator[T] {
+	return &OptionalValidator[T]{
+		validator: validator,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *OptionalValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *OptionalValidator[T]) Validate(value T) *Error {
+	if isZeroAny(value) {
+		return nil
+	}
+	return v.validator.Validate(value)
+}
+
+// ValidateAll returns every error of the wrapped validator
+func (v *OptionalValidator[T]) ValidateAll(value T) []*Error {
+	if isZeroAny(value) {
+		return nil
+	}
+	return validateAll(v.validator, value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *OptionalValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	if isZeroAny(value) {
+		return nil
+	}
+	return validateContext(ctx, v.validator, value)
+}
@@ -0,0 +1,152 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// UnknownKeyPolicy controls how ObjectValidator treats keys without a rule
+type UnknownKeyPolicy int
+
+const (
+	// UnknownAllow ignores keys that have no rule
+	UnknownAllow UnknownKeyPolicy = iota
+	// UnknownReject reports keys that have no rule and are not required
+	// as errors
+	UnknownReject
+)
+
+// ObjectValidator validates dynamic objects such as decoded JSON payloads
+// for which no Go struct exists
+type ObjectValidator struct {
+	keys     []objectKey
+	required []string
+	unknown  UnknownKeyPolicy
+}
+
+type objectKey struct {
+	name string
+	adapter
+	validator any
+}
+
+var _ Validator[map[string]any] = (*ObjectValidator)(nil)
+
+// Object creates a new validator for map[string]any values
+func Object() *ObjectValidator {
+	return &ObjectValidator{}
+}
+
+// Key adds a validation rule for the value stored under name. The validator
+// may be any validator; values of a different type than it accepts fail
+// with invalid_type. Note that encoding/json decodes numbers as float64.
+// Missing keys are skipped unless they are also listed in Required.
+func (v *ObjectValidator) Key(name string, validator interface{}) *ObjectValidator {
+	v.keys = append(v.keys, objectKey{
+		name:      name,
+		adapter:   wrapValidator(validator),
+		validator: validator,
+	})
+	return v
+}
+
+// Required marks keys that must be present
+func (v *ObjectValidator) Required(keys ...string) *ObjectValidator {
+	v.required = append(v.required, keys...)
+	return v
+}
+
+// UnknownKeys sets the policy for keys that have no rule
+func (v *ObjectValidator) UnknownKeys(policy UnknownKeyPolicy) *ObjectValidator {
+	v.unknown = policy
+	return v
+}
+
+// Compile reports misconfiguration of any key validator
+func (v *ObjectValidator) Compile() error {
+	for _, key := range v.keys {
+		if err := compileValidator(key.validator); err != nil {
+			return fmt.Errorf("key %s: %w", key.name, err)
+		}
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (v *ObjectValidator) Validate(value map[string]any) *Error {
+	if errs := v.check(context.Background(), value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every error found in the object
+func (v *ObjectValidator) ValidateAll(value map[string]any) []*Error {
+	return v.check(context.Background(), value, true)
+}
+
+// check validates required keys, per-key rules and unknown keys in order
+func (v *ObjectValidator) check(ctx context.Context, value map[string]any, all bool) []*Error {
+	var errs []*Error
+	fail := func(key string, err *Error) bool {
+		errs = append(errs, err.withPrefix(FieldSegment(key)))
+		return !all
+	}
+
+	for _, key := range v.required {
+		if _, ok := value[key]; !ok {
+			if fail(key, &Error{
+				Code:    "required",
+				Message: "field is required",
+			}) {
+				return errs
+			}
+		}
+	}
+
+	for _, key := range v.keys {
+		fieldValue, ok := value[key.name]
+		if !ok {
+			continue
+		}
+		for _, err := range key.adapter.check(ctx, fieldValue, all) {
+			if fail(key.name, err) {
+				return errs
+			}
+		}
+	}
+
+	if v.unknown == UnknownReject {
+		// Sort so unknown key errors are reported deterministically
+		var unknown []string
+		for key := range value {
+			if !v.hasKey(key) {
+				unknown = append(unknown, key)
+			}
+		}
+		sort.Strings(unknown)
+		for _, key := range unknown {
+			if fail(key, &Error{
+				Code:    "unknown_key",
+				Message: "unknown field",
+				Params:  map[string]any{"key": key},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	return errs
+}
+
+// hasKey reports whether key is known, i.e. has a rule or is required
+func (v *ObjectValidator) hasKey(key string) bool {
+	for _, k := range v.keys {
+		if k.name == key {
+			return true
+		}
+	}
+	return slices.Contains(v.required, key)
+}
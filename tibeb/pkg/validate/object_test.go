@@ -0,0 +1,18 @@
+package validate
+
+import "testing"
+
+func TestObjectRequiredKeyIsKnown(t *testing.T) {
+	v := Object().
+		Key("name", String().MinLen(1)).
+		Required("name", "id").
+		UnknownKeys(UnknownReject)
+
+	if errs := v.ValidateAll(map[string]any{"name": "x", "id": 1.0}); len(errs) != 0 {
+		t.Errorf("ValidateAll = %v, want no errors", errs)
+	}
+	errs := v.ValidateAll(map[string]any{"name": "x", "id": 1.0, "extra": true})
+	if len(errs) != 1 || errs[0].Code != "unknown_key" || errs[0].Field != "extra" {
+		t.Errorf("ValidateAll = %v, want one unknown_key error for extra", errs)
+	}
+}
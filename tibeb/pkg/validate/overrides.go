@@ -0,0 +1,79 @@
+package validate
+
+import "maps"
+
+// overrides holds per-rule customizations of a validator, keyed by the
+// name of the builder method that added the rule, such as "Min" or
+// "Custom", so rules sharing an error code are customized separately
+type overrides struct {
+	// last is the name of the most recently added rule
+	last     string
+	messages map[string]string
+	codes    map[string]string
+}
+
+// rule records name as the most recently added rule
+func (o *overrides) rule(name string) {
+	o.last = name
+}
+
+// lastRule returns the name of the most recently added rule, panicking if
+// no rule has been added yet
+func (o *overrides) lastRule(method string) string {
+	if o.last == "" {
+		panic("validate: " + method + " must follow a rule")
+	}
+	return o.last
+}
+
+// setMessage overrides the message of the most recently added rule
+func (o *overrides) setMessage(message string) {
+	rule := o.lastRule("WithMessage")
+	if o.messages == nil {
+		o.messages = make(map[string]string)
+	}
+	o.messages[rule] = message
+}
+
+// setCode overrides the code of the most recently added rule
+func (o *overrides) setCode(code string) {
+	rule := o.lastRule("WithCode")
+	if o.codes == nil {
+		o.codes = make(map[string]string)
+	}
+	o.codes[rule] = code
+}
+
+// apply returns err, reported by the named rule, rewritten according to
+// the rule's overrides. err is copied before it is changed, since Custom
+// rules may return shared errors.
+func (o *overrides) apply(rule string, err *Error) *Error {
+	message, hasMessage := o.messages[rule]
+	code, hasCode := o.codes[rule]
+	if !hasMessage && !hasCode {
+		return err
+	}
+	rewritten := *err
+	if hasMessage {
+		rewritten.Message = message
+		rewritten.fixed = true
+	}
+	if hasCode {
+		rewritten.Code = code
+	}
+	return &rewritten
+}
+
+// Message replaces the message of errors with the given code reported for
+// the most recently added field, including codes returned by Custom rules
+func (s *Schema[T]) Message(code, message string) *Schema[T] {
+	rule := s.lastRule()
+	// copy so that schemas derived from a common base never share messages
+	messages := maps.Clone(rule.messages)
+	if messages == nil {
+		messages = make(map[string]string)
+	}
+	messages[code] = message
+	rule.messages = messages
+	return s
+}
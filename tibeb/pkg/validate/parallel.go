@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Parallel validates the schema's fields concurrently on up to n
+// goroutines, for schemas whose rules are slow, such as database lookups
+// through ValidateContext or large patterns:
+//
+//	schema := validate.Struct[Signup]().Parallel(4).
+//		Field(func(s Signup) string { return s.Username }, usernameAvailable).
+//		Field(func(s Signup) string { return s.Email }, emailNotBanned)
+//
+// Errors are merged in declaration order, so the result is the same as
+// without Parallel, including under FailFast and MaxErrors. Validators and
+// When conditions must be safe for concurrent use. Parse and Normalize
+// write parsed values back to the struct and therefore always run
+// sequentially. n <= 1 disables parallel validation.
+func (s *Schema[T]) Parallel(n int) *Schema[T] {
+	s.parallel = n
+	return s
+}
+
+// ruleResult holds the outcome of one rule run by runParallel
+type ruleResult struct {
+	errs []*Error
+	// canceled is the context error seen before the rule ran
+	canceled error
+	ran      bool
+}
+
+// runParallel runs the rules on up to s.parallel goroutines and reports
+// their errors in rule order. It returns false when validation stopped
+// early because of FailFast, MaxErrors or a canceled context, in which
+// case refinements are skipped as in sequential validation.
+func (s *Schema[T]) runParallel(ctx context.Context, value T, errors *Errors) bool {
+	all := s.collectAll && !s.failFast
+	results := make([]ruleResult, len(s.rules))
+	// firstFailure is the lowest index of a failed rule under FailFast;
+	// later rules would not run sequentially, so they are skipped
+	firstFailure := atomic.Int64{}
+	firstFailure.Store(int64(len(s.rules)))
+	next := atomic.Int64{}
+
+	workers := min(s.parallel, len(s.rules))
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(s.rules) {
+					return
+				}
+				if s.failFast && int64(i) > firstFailure.Load() {
+					continue
+				}
+				result := &results[i]
+				result.ran = true
+				if err := ctx.Err(); err != nil {
+					result.canceled = err
+					continue
+				}
+				v := value
+				result.errs = s.evalRule(ctx, &v, &s.rules[i], false, all, nil)
+				if s.failFast && hasFailure(result.errs, s.rules[i].warn) {
+					for {
+						current := firstFailure.Load()
+						if int64(i) >= current || firstFailure.CompareAndSwap(current, int64(i)) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range results {
+		if s.done(errors) {
+			return false
+		}
+		result := &results[i]
+		if !result.ran {
+			continue
+		}
+		if result.canceled != nil {
+			errors.Add(contextError(result.canceled))
+			return false
+		}
+		for _, err := range result.errs {
+			s.report(errors, &s.rules[i], err)
+		}
+	}
+	return true
+}
+
+// hasFailure reports whether errs would count as errors once reported,
+// i.e. whether there are any and the rule is not a warning
+func hasFailure(errs []*Error, warn bool) bool {
+	if warn {
+		return false
+	}
+	for _, err := range errs {
+		if err.Severity != SeverityWarning {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+type order struct {
+	Customer string
+	Email    string
+	Quantity int
+	Note     string
+}
+
+// slowly delays a validator so parallel rules finish out of order
+func slowly(d time.Duration, v Validator[string]) Validator[string] {
+	return ValidatorFunc[string](func(s string) *Error {
+		time.Sleep(d)
+		return v.Validate(s)
+	})
+}
+
+func orderSchema(parallel int, configure func(*Schema[order]) *Schema[order]) *Schema[order] {
+	s := configure(Struct[order]().Parallel(parallel))
+	return s.
+		Field(func(o order) string { return o.Customer }, slowly(5*time.Millisecond, String().MinLen(3))).
+		Field(func(o order) string { return o.Email }, slowly(time.Millisecond, String().Email())).
+		Field(func(o order) int { return o.Quantity }, Int().Min(1)).
+		Field(func(o order) string { return o.Note }, String().MaxLen(3))
+}
+
+// TestParallelMatchesSequential checks that Parallel reports the same
+// errors in the same order as sequential validation
+func TestParallelMatchesSequential(t *testing.T) {
+	options := map[string]func(*Schema[order]) *Schema[order]{
+		"default":     func(s *Schema[order]) *Schema[order] { return s },
+		"fail fast":   func(s *Schema[order]) *Schema[order] { return s.FailFast() },
+		"max errors":  func(s *Schema[order]) *Schema[order] { return s.MaxErrors(2) },
+		"collect all": func(s *Schema[order]) *Schema[order] { return s.CollectAll() },
+	}
+	values := []order{
+		{},
+		{Customer: "ab", Email: "nope", Quantity: 0, Note: "long"},
+		{Customer: "abebe", Email: "a@b.co", Quantity: 0, Note: "long"},
+		{Customer: "abebe", Email: "a@b.co", Quantity: 2, Note: "ok"},
+	}
+	for name, configure := range options {
+		t.Run(name, func(t *testing.T) {
+			sequential := orderSchema(0, configure)
+			parallel := orderSchema(4, configure)
+			for _, value := range values {
+				want := sequential.Validate(value).Format()
+				if got := parallel.Validate(value).Format(); got != want {
+					t.Errorf("Validate(%+v):\n%s\nwant:\n%s", value, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	value := order{Customer: "ab"}
+	configure := func(s *Schema[order]) *Schema[order] { return s }
+
+	want := orderSchema(0, configure).ValidateContext(ctx, value).Format()
+	if got := orderSchema(4, configure).ValidateContext(ctx, value).Format(); got != want {
+		t.Errorf("ValidateContext = %q, want %q", got, want)
+	}
+}
+
+// TestParallelConcurrentUse shares a parallel schema between goroutines;
+// run with -race
+func TestParallelConcurrentUse(t *testing.T) {
+	schema := orderSchema(3, func(s *Schema[order]) *Schema[order] { return s.CollectAll() })
+	value := order{Customer: "ab", Email: "nope", Note: "long"}
+	want := schema.Validate(value).Format()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				if got := schema.Validate(value).Format(); got != want {
+					t.Errorf("Validate = %q, want %q", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+)
+
+// SegmentKind identifies what a PathSegment refers to
+type SegmentKind int
+
+const (
+	// SegmentField is a struct field name
+	SegmentField SegmentKind = iota
+	// SegmentIndex is a slice or array index
+	SegmentIndex
+	// SegmentKey is a map key
+	SegmentKey
+)
+
+// PathSegment is a single step in a Path. Name is set for field segments,
+// Index for index segments and Key for map key segments.
+type PathSegment struct {
+	Kind  SegmentKind
+	Name  string
+	Index int
+	Key   string
+}
+
+// Path locates a value inside a validated structure, e.g. Items[0].SKU or
+// Labels["env"]
+type Path []PathSegment
+
+// FieldSegment creates a path segment for a struct field
+func FieldSegment(name string) PathSegment {
+	return PathSegment{Kind: SegmentField, Name: name}
+}
+
+// dottedPath splits a dotted field name such as Audit.CreatedBy into field
+// segments
+func dottedPath(name string) Path {
+	var path Path
+	for _, part := range strings.Split(name, ".") {
+		path = append(path, FieldSegment(part))
+	}
+	return path
+}
+
+// IndexSegment creates a path segment for a slice index
+func IndexSegment(index int) PathSegment {
+	return PathSegment{Kind: SegmentIndex, Index: index}
+}
+
+// KeySegment creates a path segment for a map key
+func KeySegment(key string) PathSegment {
+	return PathSegment{Kind: SegmentKey, Key: key}
+}
+
+// String renders the path in dot notation, e.g. Items[0].SKU, with map keys
+// quoted as in Labels["env"]
+func (p Path) String() string {
+	var b strings.Builder
+	for _, seg := range p {
+		switch seg.Kind {
+		case SegmentIndex:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(seg.Index))
+			b.WriteByte(']')
+		case SegmentKey:
+			b.WriteByte('[')
+			b.WriteString(strconv.Quote(seg.Key))
+			b.WriteByte(']')
+		default:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(seg.Name)
+		}
+	}
+	return b.String()
+}
+
+// compare orders paths segment by segment: field names and map keys
+// lexically, indexes numerically and fields before indexes before keys,
+// with a path ordered before the paths it is a prefix of
+func (p Path) compare(q Path) int {
+	for i := 0; i < len(p) && i < len(q); i++ {
+		a, b := p[i], q[i]
+		if a.Kind != b.Kind {
+			return cmp.Compare(a.Kind, b.Kind)
+		}
+		if c := cmp.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Index, b.Index); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Key, b.Key); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(p), len(q))
+}
+
+// JSONPointer renders the path as an RFC 6901 JSON Pointer, e.g. /Items/0/SKU
+func (p Path) JSONPointer() string {
+	var b strings.Builder
+	for _, seg := range p {
+		b.WriteByte('/')
+		switch seg.Kind {
+		case SegmentIndex:
+			b.WriteString(strconv.Itoa(seg.Index))
+		case SegmentKey:
+			b.WriteString(escapePointer(seg.Key))
+		default:
+			b.WriteString(escapePointer(seg.Name))
+		}
+	}
+	return b.String()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapePointer(s string) string {
+	return pointerEscaper.Replace(s)
+}
+
+// withPrefix returns a copy of the error with segments prepended to its
+// path and Field refreshed. Validators may return shared errors, such as
+// package-level sentinels from Custom, so e itself is never modified.
+func (e *Error) withPrefix(segments ...PathSegment) *Error {
+	path := make(Path, 0, len(segments)+len(e.Path))
+	for _, seg := range segments {
+		if seg.Kind == SegmentField && seg.Name == "" {
+			continue
+		}
+		path = append(path, seg)
+	}
+	prefixed := *e
+	prefixed.Path = append(path, e.Path...)
+	prefixed.Field = prefixed.Path.String()
+	return &prefixed
+}
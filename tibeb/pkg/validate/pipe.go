@@ -0,0 +1,113 @@
+package validate
+
+// PipeValidator parses a value into another type, transforms the result
+// and validates it, packaging normalization as one reusable unit
+type PipeValidator[T, U any] struct {
+	parseFunc  ParseFunc[T, U]
+	transforms []TransformFunc[U]
+	validator  Validator[U]
+}
+
+var (
+	_ MultiValidator[string] = (*PipeValidator[string, int])(nil)
+	_ Parser[string]         = (*PipeValidator[string, int])(nil)
+)
+
+// Pipe creates a validator that runs parse, then each transform added
+// with Transform, then validator on the result, e.g.
+//
+//	quantity := validate.Pipe(strconv.Atoi, validate.Int().Between(1, 99)).
+//		Transform(func(n int) int { return max(n, 1) })
+//	n, err := quantity.Convert("12")
+//
+// A parse error is reported as parse_error with the error as its Cause.
+func Pipe[T, U any](parse ParseFunc[T, U], validator Validator[U]) *PipeValidator[T, U] {
+	return &PipeValidator[T, U]{
+		parseFunc: parse,
+		validator: validator,
+	}
+}
+
+// Map creates a validator that converts a value to another type with fn
+// and validates the result, e.g. string → time.Time → validated:
+//
+//	deadline := validate.Map(func(s string) (time.Time, error) {
+//		return time.Parse(time.RFC3339, s)
+//	}, validate.Time().Future())
+//	t, err := deadline.Convert("2030-01-02T15:04:05Z")
+//
+// It is Pipe under a name that reads better for type changes. Convert
+// returns the converted value; Schema.Parse stores it only when it has the
+// field's type, see Parse.
+func Map[T, U any](fn func(T) (U, error), validator Validator[U]) *PipeValidator[T, U] {
+	return Pipe(fn, validator)
+}
+
+// Transform adds a transformation applied to the parsed value before it
+// is validated
+func (v *PipeValidator[T, U]) Transform(fn TransformFunc[U]) *PipeValidator[T, U] {
+	v.transforms = append(v.transforms, fn)
+	return v
+}
+
+// Compile reports misconfiguration of the terminal validator
+func (v *PipeValidator[T, U]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Convert runs the pipeline and returns the parsed, transformed value.
+// When the terminal validator implements Parser, its result is returned.
+func (v *PipeValidator[T, U]) Convert(value T) (U, *Error) {
+	parsed, err := v.parse(value)
+	if err != nil {
+		return parsed, err
+	}
+	if parser, ok := v.validator.(Parser[U]); ok {
+		return parser.Parse(parsed)
+	}
+	return parsed, v.validator.Validate(parsed)
+}
+
+// Parse implements Parser, so Schema.Parse stores the converted value when
+// it has the input's type, as for a string → string normalization. A value
+// converted to another type cannot be stored in the field, so value is
+// returned unchanged along with any validation error.
+func (v *PipeValidator[T, U]) Parse(value T) (T, *Error) {
+	converted, err := v.Convert(value)
+	if result, ok := any(converted).(T); ok && err == nil {
+		return result, nil
+	}
+	return value, err
+}
+
+// Validate implements the Validator interface
+func (v *PipeValidator[T, U]) Validate(value T) *Error {
+	_, err := v.Convert(value)
+	return err
+}
+
+// ValidateAll returns the parse error or every rule the transformed value
+// violates
+func (v *PipeValidator[T, U]) ValidateAll(value T) []*Error {
+	parsed, err := v.parse(value)
+	if err != nil {
+		return []*Error{err}
+	}
+	return validateAll(v.validator, parsed)
+}
+
+// parse runs the parse function and the transformations
+func (v *PipeValidator[T, U]) parse(value T) (U, *Error) {
+	parsed, err := v.parseFunc(value)
+	if err != nil {
+		return parsed, &Error{
+			Code:    "parse_error",
+			Message: "failed to parse value: " + err.Error(),
+			Cause:   err,
+		}
+	}
+	for _, transform := range v.transforms {
+		parsed = transform(parsed)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMapConvert(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	deadline := Map(func(s string) (time.Time, error) {
+		return time.Parse(time.RFC3339, s)
+	}, Time().Future().Clock(func() time.Time { return now }))
+
+	got, err := deadline.Convert("2030-01-02T15:04:05Z")
+	if err != nil || !got.Equal(time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("Convert = %v, %v", got, err)
+	}
+	if err := deadline.Validate("2020-01-02T15:04:05Z"); err == nil || err.Code != "too_early" {
+		t.Errorf("Validate past deadline = %v, want too_early", err)
+	}
+	if err := deadline.Validate("soon"); err == nil || err.Code != "parse_error" {
+		t.Errorf("Validate unparsable = %v, want parse_error", err)
+	}
+}
+
+type slugged struct {
+	Slug     string
+	Deadline string
+}
+
+func TestMapInSchemaParse(t *testing.T) {
+	schema := Struct[slugged]().
+		Field(func(s slugged) string { return s.Slug }, Map(func(s string) (string, error) {
+			return strings.ToLower(strings.TrimSpace(s)), nil
+		}, String().MinLen(2))).
+		Field(func(s slugged) string { return s.Deadline }, Map(func(s string) (time.Time, error) {
+			return time.Parse(time.RFC3339, s)
+		}, Time()))
+
+	parsed, errs := schema.Parse(slugged{Slug: "  Hello ", Deadline: "2030-01-02T15:04:05Z"})
+	if errs.HasErrors() {
+		t.Fatal(errs.Format())
+	}
+	if parsed.Slug != "hello" {
+		t.Errorf("Slug = %q, want the converted value", parsed.Slug)
+	}
+	if parsed.Deadline != "2030-01-02T15:04:05Z" {
+		t.Errorf("Deadline = %q, want it unchanged", parsed.Deadline)
+	}
+}
@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"regexp"
+	"sync"
+)
+
+// maxCachedRegexps bounds regexCache, so building validators from
+// untrusted or generated patterns cannot grow memory without limit
+const maxCachedRegexps = 1024
+
+// regexCache holds compiled regular expressions keyed by pattern so that
+// schemas using the same pattern share a single compiled *regexp.Regexp.
+// Once it holds maxCachedRegexps patterns, new patterns are compiled
+// without being cached.
+var regexCache = struct {
+	sync.Mutex
+	patterns map[string]*regexp.Regexp
+}{patterns: make(map[string]*regexp.Regexp)}
+
+// compileRegexp returns the cached compiled form of pattern, compiling and
+// caching it on first use. Invalid patterns are not cached.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	regexCache.Lock()
+	re, ok := regexCache.patterns[pattern]
+	regexCache.Unlock()
+	if ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Lock()
+	defer regexCache.Unlock()
+	if cached, ok := regexCache.patterns[pattern]; ok {
+		return cached, nil
+	}
+	if len(regexCache.patterns) < maxCachedRegexps {
+		regexCache.patterns[pattern] = re
+	}
+	return re, nil
+}
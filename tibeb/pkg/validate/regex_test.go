@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestCompileRegexpShared(t *testing.T) {
+	first, err := compileRegexp(`^shared-[0-9]+$`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := compileRegexp(`^shared-[0-9]+$`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("the same pattern compiled twice instead of being shared")
+	}
+	if _, err := compileRegexp(`(`); err == nil {
+		t.Error("invalid pattern compiled without error")
+	}
+}
+
+func TestCompileRegexpBounded(t *testing.T) {
+	regexCache.Lock()
+	saved := regexCache.patterns
+	regexCache.patterns = make(map[string]*regexp.Regexp)
+	regexCache.Unlock()
+	t.Cleanup(func() {
+		regexCache.Lock()
+		regexCache.patterns = saved
+		regexCache.Unlock()
+	})
+
+	for i := range maxCachedRegexps + 10 {
+		if _, err := compileRegexp(fmt.Sprintf("^p%d$", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	regexCache.Lock()
+	n := len(regexCache.patterns)
+	regexCache.Unlock()
+	if n != maxCachedRegexps {
+		t.Errorf("cache holds %d patterns, want %d", n, maxCachedRegexps)
+	}
+
+	re, err := compileRegexp("^uncached$")
+	if err != nil || !re.MatchString("uncached") {
+		t.Errorf("pattern beyond the bound = %v, %v; want a working regexp", re, err)
+	}
+}
@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownSchema is returned by Registry.Validate when no schema is
+// registered under the requested name and version
+var ErrUnknownSchema = errors.New("validate: unknown schema")
+
+// Registry holds schemas registered under a name and version, so APIs that
+// accept several payload versions can dispatch validation by version. It
+// is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	schemas map[string]map[int]registryEntry
+}
+
+// registryEntry is a registered schema with its type erased
+type registryEntry struct {
+	schema   any
+	validate func(any) (*Errors, bool)
+	typ      reflect.Type
+}
+
+// NewRegistry creates an empty schema registry
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: make(map[string]map[int]registryEntry),
+	}
+}
+
+// Register adds schema to r under name and version. It panics if that
+// version of name is already registered.
+func Register[T any](r *Registry, name string, version int, schema *Schema[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions := r.schemas[name]
+	if versions == nil {
+		versions = make(map[int]registryEntry)
+		r.schemas[name] = versions
+	}
+	if _, ok := versions[version]; ok {
+		panic(fmt.Sprintf("validate: schema %s v%d already registered", name, version))
+	}
+	versions[version] = registryEntry{
+		schema: schema,
+		validate: func(value any) (*Errors, bool) {
+			v, ok := value.(T)
+			if !ok {
+				return nil, false
+			}
+			return schema.Validate(v), true
+		},
+		typ: reflect.TypeOf((*T)(nil)).Elem(),
+	}
+}
+
+// Lookup returns the schema registered under name and version, reporting
+// false if there is none or it validates a type other than T
+func Lookup[T any](r *Registry, name string, version int) (*Schema[T], bool) {
+	entry, ok := r.entry(name, version)
+	if !ok {
+		return nil, false
+	}
+	schema, ok := entry.schema.(*Schema[T])
+	return schema, ok
+}
+
+// Validate validates value against version of the schema registered under
+// name. It returns an error wrapping ErrUnknownSchema if there is no such
+// schema, or an error if value has the wrong type for it.
+func (r *Registry) Validate(name string, version int, value any) (*Errors, error) {
+	entry, ok := r.entry(name, version)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnknownSchema, name, version)
+	}
+	errs, ok := entry.validate(value)
+	if !ok {
+		return nil, fmt.Errorf("validate: schema %s v%d validates %s, got %T", name, version, entry.typ, value)
+	}
+	return errs, nil
+}
+
+// Versions returns the registered versions of name in ascending order
+func (r *Registry) Versions(name string) []int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	versions := make([]int, 0, len(r.schemas[name]))
+	for version := range r.schemas[name] {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	return versions
+}
+
+// Latest returns the highest registered version of name, reporting false
+// if name has no versions
+func (r *Registry) Latest(name string) (int, bool) {
+	versions := r.Versions(name)
+	if len(versions) == 0 {
+		return 0, false
+	}
+	return versions[len(versions)-1], true
+}
+
+// entry returns the registered schema for name and version
+func (r *Registry) entry(name string, version int) (registryEntry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, ok := r.schemas[name][version]
+	return entry, ok
+}
@@ -0,0 +1,256 @@
+package validate
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// derive returns a copy of the schema's configuration with the given rules.
+// The copy's slices are clipped so that adding rules or refinements to
+// either schema later reallocates instead of writing into the other's
+// backing array.
+func (s *Schema[T]) derive(rules []FieldRule[T]) *Schema[T] {
+	derived := *s
+	derived.rules = slices.Clip(rules)
+	derived.refinements = slices.Clip(s.refinements)
+	derived.updates = slices.Clip(s.updates)
+	derived.preprocess = slices.Clip(s.preprocess)
+	derived.after = slices.Clip(s.after)
+	return &derived
+}
+
+// Clone returns an independent copy of the schema with its own rules,
+// refinements and options, so request-scoped tweaks such as relaxing a
+// rule for admin users do not affect the shared original. Validators are
+// shared between the copies; they are never modified by validation.
+func (s *Schema[T]) Clone() *Schema[T] {
+	clone := s.derive(append([]FieldRule[T](nil), s.rules...))
+	clone.refinements = append([]func(T) *Error(nil), s.refinements...)
+	clone.updates = append([]func(old, new T) *Error(nil), s.updates...)
+	clone.preprocess = slices.Clone(s.preprocess)
+	clone.after = slices.Clone(s.after)
+	return clone
+}
+
+// Extend returns a new schema containing the rules of s followed by the
+// rules of every other schema, along with their refinements, update rules
+// and hooks. Neither s nor the others are modified.
+func (s *Schema[T]) Extend(others ...*Schema[T]) *Schema[T] {
+	extended := s.Clone()
+	for _, other := range others {
+		extended.rules = append(extended.rules, other.rules...)
+		extended.refinements = append(extended.refinements, other.refinements...)
+		extended.updates = append(extended.updates, other.updates...)
+		extended.preprocess = append(extended.preprocess, other.preprocess...)
+		extended.after = append(extended.after, other.after...)
+	}
+	return extended
+}
+
+// Merge returns a new schema containing the rules, refinements and hooks
+// of all given schemas in order, using the options of the first
+func Merge[T any](schemas ...*Schema[T]) *Schema[T] {
+	if len(schemas) == 0 {
+		return Struct[T]()
+	}
+	return schemas[0].Extend(schemas[1:]...)
+}
+
+// ExtendWith returns a new schema containing the rules of s plus the rules
+// of base applied to the value returned by selector. It lets a shared
+// schema, such as one for audit fields, be reused across many entity
+// schemas. Errors are reported under the path of the selected field, such
+// as Audit.CreatedBy; fields of an embedded struct keep the base schema's
+// names, matching Go's promoted field names. Parse writes parsed values back to the
+// field's actual location in T, such as Audit.CreatedBy, and skips
+// write-back when that location cannot be resolved. Every option of
+// base's rules, such as When, Label or Warn, carries over, as do base's
+// refinements, update rules and AfterValidate hooks. Preprocess functions
+// of base cannot be applied to T and are not carried over.
+func ExtendWith[T, E any](s *Schema[T], selector func(T) E, base *Schema[E]) *Schema[T] {
+	extended := s.Clone()
+	prefix := selectorPrefix(selector)
+	for _, rule := range base.rules {
+		extended.rules = append(extended.rules, liftRule(rule, selector, prefix))
+	}
+	for _, refine := range base.refinements {
+		extended.refinements = append(extended.refinements, func(t T) *Error {
+			if inner, ok := selectValue(selector, t); ok {
+				return refine(inner)
+			}
+			return nil
+		})
+	}
+	for _, update := range base.updates {
+		extended.updates = append(extended.updates, func(old, new T) *Error {
+			oldInner, oldOK := selectValue(selector, old)
+			newInner, newOK := selectValue(selector, new)
+			if !oldOK || !newOK {
+				return nil
+			}
+			return update(oldInner, newInner)
+		})
+	}
+	for _, fn := range base.after {
+		extended.after = append(extended.after, func(t T, errs *Errors) {
+			if inner, ok := selectValue(selector, t); ok {
+				fn(inner, errs)
+			}
+		})
+	}
+	return extended
+}
+
+// liftRule converts a rule of E into a rule of T that applies to the
+// value selector returns, keeping all of its options and reporting its
+// field under prefix
+func liftRule[T, E any](rule FieldRule[E], selector func(T) E, prefix string) FieldRule[T] {
+	lifted := FieldRule[T]{
+		selector:     func(t T) any { return rule.selector(selector(t)) },
+		adapter:      rule.adapter,
+		fieldOptions: rule.fieldOptions,
+	}
+	if prefix != "" && rule.field != "" {
+		lifted.field = prefix + "." + rule.field
+	}
+	if rule.direct != nil {
+		lifted.direct = func(ctx context.Context, t T) (*Error, bool) {
+			inner, ok := selectValue(selector, t)
+			if !ok {
+				return nil, false
+			}
+			return rule.direct(ctx, inner)
+		}
+	}
+	if rule.when != nil {
+		lifted.when = func(t T) bool {
+			inner, ok := selectValue(selector, t)
+			return ok && rule.when(inner)
+		}
+	}
+	if rule.requiredIf != nil {
+		lifted.requiredIf = func(t T) bool {
+			inner, ok := selectValue(selector, t)
+			return ok && rule.requiredIf(inner)
+		}
+	}
+	lifted.path = liftedPath[T, E](lifted.selector, rule.path)
+	return lifted
+}
+
+// selectorPrefix returns the path of the struct field of T that selector
+// returns, leaving out embedded structs since Go promotes their fields, or
+// "" when the field cannot be resolved. A selector that dereferences a
+// pointer field resolves to that field.
+func selectorPrefix[T, E any](selector func(T) E) string {
+	target := reflect.TypeOf((*E)(nil)).Elem()
+	path, resolved := fieldName(func(t T) any { return selector(t) }, target)
+	if !resolved || path == "" {
+		return ""
+	}
+	fields, ok := fieldByPath(reflect.TypeOf((*T)(nil)).Elem(), path)
+	if !ok {
+		return ""
+	}
+	// probing descends into a pointer field to the first field that changes
+	// the selected value, so cut the path after the selected field
+	end := -1
+	for i, field := range fields {
+		if field.Type == target || field.Type == reflect.PointerTo(target) {
+			end = i
+		}
+	}
+	var names []string
+	for _, field := range fields[:end+1] {
+		if !field.Anonymous {
+			names = append(names, field.Name)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// liftedPath resolves the path within T of the field a lifted rule selects,
+// given its path within E. It is empty, disabling write-back, when the
+// base rule's path is unknown or probing T cannot find the field, since
+// the base schema's field name may name a different field of T.
+func liftedPath[T, E any](selector func(T) any, path string) string {
+	if path == "" {
+		return ""
+	}
+	fields, ok := fieldByPath(reflect.TypeOf((*E)(nil)).Elem(), path)
+	if !ok {
+		return ""
+	}
+	name, resolved := fieldName(selector, fields[len(fields)-1].Type)
+	if !resolved {
+		return ""
+	}
+	return name
+}
+
+// Pick returns a new schema containing only the rules for the named fields.
+// A struct field such as Audit also picks its nested fields, such as
+// Audit.CreatedBy.
+func (s *Schema[T]) Pick(fields ...string) *Schema[T] {
+	return s.filter(fields, true)
+}
+
+// Omit returns a new schema without the rules for the named fields and
+// the fields nested in them
+func (s *Schema[T]) Omit(fields ...string) *Schema[T] {
+	return s.filter(fields, false)
+}
+
+// filter keeps the rules whose field is (keep) or is not (!keep) in fields
+// or nested in one of them
+func (s *Schema[T]) filter(fields []string, keep bool) *Schema[T] {
+	var rules []FieldRule[T]
+	for _, rule := range s.rules {
+		if inMask(fields, rule.field) == keep {
+			rules = append(rules, rule)
+		}
+	}
+	return s.derive(rules)
+}
+
+// ValidateMasked validates only the rules for fields named in mask, for
+// gRPC FieldMask updates and JSON Merge Patch handlers that must not
+// complain about fields that were not sent. Mask paths may use Go field
+// names or, with UseJSONNames, json names; a path such as "address" also
+// selects nested fields like "address.city". Refinements are skipped since
+// they may depend on fields outside the mask.
+func (s *Schema[T]) ValidateMasked(value T, mask []string) *Errors {
+	var rules []FieldRule[T]
+	for _, rule := range s.rules {
+		if inMask(mask, rule.field) || inMask(mask, s.reportedName(&rule)) {
+			rules = append(rules, rule)
+		}
+	}
+	masked := s.derive(rules)
+	masked.refinements = nil
+	return masked.Validate(value)
+}
+
+// inMask reports whether name or one of its parent paths is in mask
+func inMask(mask []string, name string) bool {
+	for _, path := range mask {
+		if name == path || strings.HasPrefix(name, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Partial returns a new schema in which every field rule is skipped when
+// the field holds its zero value, so PATCH-style updates can reuse the
+// create schema
+func (s *Schema[T]) Partial() *Schema[T] {
+	rules := make([]FieldRule[T], len(s.rules))
+	for i, rule := range s.rules {
+		rule.optional = true
+		rules[i] = rule
+	}
+	return s.derive(rules)
+}
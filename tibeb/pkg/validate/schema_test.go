@@ -0,0 +1,63 @@
+package validate
+
+import "testing"
+
+func TestPickOmitNestedFields(t *testing.T) {
+	schema := Struct[document]().
+		Field(func(d document) string { return d.Title }, String().Required()).
+		Field(func(d document) string { return d.Audit.CreatedBy }, String().Required())
+
+	if errs := schema.Omit("Audit").Validate(document{}); errs.Has("Audit.CreatedBy") || !errs.Has("Title") {
+		t.Errorf("Omit(Audit) = %s, want only Title", errs.Format())
+	}
+	if errs := schema.Pick("Audit").Validate(document{}); !errs.Has("Audit.CreatedBy") || errs.Has("Title") {
+		t.Errorf("Pick(Audit) = %s, want only Audit.CreatedBy", errs.Format())
+	}
+	if errs := schema.Omit("Aud").Validate(document{}); !errs.Has("Audit.CreatedBy") {
+		t.Errorf("Omit(Aud) = %s, want Audit.CreatedBy kept", errs.Format())
+	}
+}
+
+type stamp struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type ticket struct {
+	Stamp stamp  `json:"stamp"`
+	Ref   *stamp `json:"ref"`
+}
+
+type embeddedTicket struct {
+	stamp
+}
+
+func stampSchema() *Schema[stamp] {
+	return Struct[stamp]().Field(func(s stamp) string { return s.CreatedBy }, String().MinLen(3).Trim())
+}
+
+func TestExtendWithNamedField(t *testing.T) {
+	schema := ExtendWith(Struct[ticket](), func(t ticket) stamp { return t.Stamp }, stampSchema())
+
+	if errs := schema.Validate(ticket{}); !errs.Has("Stamp.CreatedBy") {
+		t.Errorf("Validate = %s, want an error for Stamp.CreatedBy", errs.Format())
+	}
+	if errs := schema.UseJSONNames().Validate(ticket{}); !errs.Has("stamp.created_by") {
+		t.Errorf("Validate with json names = %s, want an error for stamp.created_by", errs.Format())
+	}
+	parsed, errs := schema.Parse(ticket{Stamp: stamp{CreatedBy: "  ada  "}})
+	if errs.HasErrors() || parsed.Stamp.CreatedBy != "ada" {
+		t.Errorf("Parse = %q, %s; want the trimmed value written to Stamp", parsed.Stamp.CreatedBy, errs.Format())
+	}
+
+	pointer := ExtendWith(Struct[ticket](), func(t ticket) stamp { return *t.Ref }, stampSchema())
+	if errs := pointer.Validate(ticket{Ref: &stamp{}}); !errs.Has("Ref.CreatedBy") {
+		t.Errorf("Validate through a pointer = %s, want an error for Ref.CreatedBy", errs.Format())
+	}
+}
+
+func TestExtendWithEmbeddedField(t *testing.T) {
+	schema := ExtendWith(Struct[embeddedTicket](), func(t embeddedTicket) stamp { return t.stamp }, stampSchema())
+	if errs := schema.Validate(embeddedTicket{}); !errs.Has("CreatedBy") {
+		t.Errorf("Validate = %s, want an error for the promoted CreatedBy", errs.Format())
+	}
+}
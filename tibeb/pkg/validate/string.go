@@ -1,21 +1,45 @@
 package validate
 
 import (
+	"errors"
 	"fmt"
+	"net/mail"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // StringValidator validates string values
 type StringValidator struct {
+	overrides
 	minLen     *int
 	maxLen     *int
+	length     *int
+	maxBytes   *int
+	runes      bool
+	prefix     *string
+	suffix     *string
+	contains   *string
 	pattern    *regexp.Regexp
-	email      bool
+	patternErr error
+	anyPattern bool // Pattern(""), which matches every string
+	alpha      bool
+	alnum      bool
+	numeric    bool
+	ascii      bool
+	lower      bool
+	upper      bool
+	printable  bool
+	noControl  bool
+	email      *EmailMode
 	custom     func(string) *Error
 	required   bool
 	defaultVal *string
+	defaultFn  func() string
+	catchVal   *string
 	optional   bool
+	trim       bool
 }
 
 var _ Validator[string] = (*StringValidator)(nil)
@@ -28,29 +52,170 @@ func String() *StringValidator {
 // MinLen adds a minimum length validation rule
 func (v *StringValidator) MinLen(length int) *StringValidator {
 	v.minLen = &length
+	v.rule("MinLen")
 	return v
 }
 
 // MaxLen adds a maximum length validation rule
 func (v *StringValidator) MaxLen(length int) *StringValidator {
 	v.maxLen = &length
+	v.rule("MaxLen")
 	return v
 }
 
-// Pattern adds a regular expression pattern validation rule
+// Len adds an exact length validation rule
+func (v *StringValidator) Len(length int) *StringValidator {
+	v.length = &length
+	v.rule("Len")
+	return v
+}
+
+// MaxBytes adds a maximum encoded size rule. Unlike MaxLen it always
+// counts bytes, even when RuneLength is set, and fails with its own
+// too_many_bytes code.
+func (v *StringValidator) MaxBytes(n int) *StringValidator {
+	v.maxBytes = &n
+	v.rule("MaxBytes")
+	return v
+}
+
+// RuneLength makes MinLen, MaxLen and Len count characters (runes)
+// instead of bytes, so multi-byte UTF-8 text is measured correctly
+func (v *StringValidator) RuneLength() *StringValidator {
+	v.runes = true
+	return v
+}
+
+// StartsWith adds a validation rule requiring the given prefix
+func (v *StringValidator) StartsWith(prefix string) *StringValidator {
+	v.prefix = &prefix
+	v.rule("StartsWith")
+	return v
+}
+
+// EndsWith adds a validation rule requiring the given suffix
+func (v *StringValidator) EndsWith(suffix string) *StringValidator {
+	v.suffix = &suffix
+	v.rule("EndsWith")
+	return v
+}
+
+// Contains adds a validation rule requiring the given substring
+func (v *StringValidator) Contains(substr string) *StringValidator {
+	v.contains = &substr
+	v.rule("Contains")
+	return v
+}
+
+// Pattern adds a regular expression pattern validation rule. An invalid
+// pattern does not panic; it is reported by Compile and fails validation
+// with an invalid_pattern error. An empty pattern matches every string,
+// so Compile reports it as a likely mistake, but validation accepts it.
 func (v *StringValidator) Pattern(pattern string) *StringValidator {
-	v.pattern = regexp.MustCompile(pattern)
+	v.rule("Pattern")
+	v.anyPattern = pattern == ""
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		v.pattern = nil
+		v.patternErr = fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		return v
+	}
+	v.pattern = re
+	v.patternErr = nil
 	return v
 }
 
+// PatternSafe adds a regular expression pattern validation rule and
+// returns an error instead of deferring it if the pattern does not compile
+func (v *StringValidator) PatternSafe(pattern string) (*StringValidator, error) {
+	v.Pattern(pattern)
+	return v, v.patternErr
+}
+
 // Matches adds a regular expression pattern validation rule (alias for Pattern)
 func (v *StringValidator) Matches(pattern string) *StringValidator {
 	return v.Pattern(pattern)
 }
 
+// Alpha requires the string to contain only letters
+func (v *StringValidator) Alpha() *StringValidator {
+	v.alpha = true
+	v.rule("Alpha")
+	return v
+}
+
+// Alphanumeric requires the string to contain only letters and digits
+func (v *StringValidator) Alphanumeric() *StringValidator {
+	v.alnum = true
+	v.rule("Alphanumeric")
+	return v
+}
+
+// Numeric requires the string to contain only the digits 0-9
+func (v *StringValidator) Numeric() *StringValidator {
+	v.numeric = true
+	v.rule("Numeric")
+	return v
+}
+
+// ASCII requires the string to contain only ASCII characters
+func (v *StringValidator) ASCII() *StringValidator {
+	v.ascii = true
+	v.rule("ASCII")
+	return v
+}
+
+// IsLowercase requires the string to already be in lowercase
+func (v *StringValidator) IsLowercase() *StringValidator {
+	v.lower = true
+	v.rule("IsLowercase")
+	return v
+}
+
+// IsUppercase requires the string to already be in uppercase
+func (v *StringValidator) IsUppercase() *StringValidator {
+	v.upper = true
+	v.rule("IsUppercase")
+	return v
+}
+
+// Printable requires every character to be printable; tabs, newlines
+// and all other control characters are rejected
+func (v *StringValidator) Printable() *StringValidator {
+	v.printable = true
+	v.rule("Printable")
+	return v
+}
+
+// NoControlChars rejects control characters such as NUL bytes and ANSI
+// escape sequences while still allowing tabs and line breaks
+func (v *StringValidator) NoControlChars() *StringValidator {
+	v.noControl = true
+	v.rule("NoControlChars")
+	return v
+}
+
 // Default sets a default value to use if the string is empty
 func (v *StringValidator) Default(val string) *StringValidator {
 	v.defaultVal = &val
+	v.defaultFn = nil
+	return v
+}
+
+// DefaultFunc sets a function called at validation time for the value to
+// use if the string is empty, for defaults such as generated IDs that must
+// not be fixed when the schema is built
+func (v *StringValidator) DefaultFunc(fn func() string) *StringValidator {
+	v.defaultFn = fn
+	v.defaultVal = nil
+	return v
+}
+
+// TrimSpace removes leading and trailing whitespace before the default and
+// every other rule apply, and Parse returns the trimmed value. Unlike
+// Trim it keeps the StringValidator chain, so TrimSpace().MinLen(3) works.
+func (v *StringValidator) TrimSpace() *StringValidator {
+	v.trim = true
 	return v
 }
 
@@ -62,42 +227,148 @@ func (v *StringValidator) Optional() *StringValidator {
 
 // Catch sets a fallback value to use if validation fails
 func (v *StringValidator) Catch(val string) *StringValidator {
-	// For now, we'll store the catch value but not implement the full logic
-	// This would need more complex error handling
+	v.catchVal = &val
 	return v
 }
 
-// Email adds an email validation rule
-func (v *StringValidator) Email() *StringValidator {
-	v.email = true
+// EmailMode selects how strictly Email validates addresses
+type EmailMode int
+
+const (
+	// EmailBasic only checks for the presence of "@" and "."
+	EmailBasic EmailMode = iota
+	// EmailStrict parses the address per RFC 5322 and enforces
+	// the RFC 5321 length limits on the local and domain parts
+	EmailStrict
+)
+
+// String returns "basic" or "strict"
+func (m EmailMode) String() string {
+	if m == EmailStrict {
+		return "strict"
+	}
+	return "basic"
+}
+
+// Email adds an email validation rule. The mode defaults to EmailBasic.
+func (v *StringValidator) Email(mode ...EmailMode) *StringValidator {
+	m := EmailBasic
+	if len(mode) > 0 {
+		m = mode[0]
+	}
+	v.email = &m
+	v.rule("Email")
 	return v
 }
 
 // Required adds a required field validation rule
 func (v *StringValidator) Required() *StringValidator {
 	v.required = true
+	v.rule("Required")
 	return v
 }
 
 // Custom adds a custom validation rule
 func (v *StringValidator) Custom(fn func(string) *Error) *StringValidator {
 	v.custom = fn
+	v.rule("Custom")
+	return v
+}
+
+// WithMessage replaces the message of the most recently added rule, e.g.
+// MinLen(5).WithMessage("Please choose a longer username")
+func (v *StringValidator) WithMessage(message string) *StringValidator {
+	v.setMessage(message)
 	return v
 }
 
+// WithCode replaces the error code of the most recently added rule, e.g.
+// MinLen(3).WithCode("USR_001") for organization-specific error contracts
+func (v *StringValidator) WithCode(code string) *StringValidator {
+	v.setCode(code)
+	return v
+}
+
+// Compile reports any misconfiguration of the validator, such as an
+// invalid or empty regular expression passed to Pattern or length rules
+// no value can satisfy
+func (v *StringValidator) Compile() error {
+	if v.patternErr != nil {
+		return v.patternErr
+	}
+	switch {
+	case v.anyPattern:
+		return errors.New("empty pattern")
+	case v.minLen != nil && v.maxLen != nil && *v.minLen > *v.maxLen:
+		return fmt.Errorf("min length %d is greater than max length %d", *v.minLen, *v.maxLen)
+	case v.length != nil && v.minLen != nil && *v.length < *v.minLen:
+		return fmt.Errorf("length %d is less than min length %d", *v.length, *v.minLen)
+	case v.length != nil && v.maxLen != nil && *v.length > *v.maxLen:
+		return fmt.Errorf("length %d is greater than max length %d", *v.length, *v.maxLen)
+	}
+	return nil
+}
+
 // Validate implements the Validator interface
 func (v *StringValidator) Validate(value string) *Error {
+	_, err := v.Parse(value)
+	return err
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *StringValidator) ValidateAll(value string) []*Error {
+	_, errs := v.parse(value, true)
+	return errs
+}
+
+// Parse validates the value and returns it after applying the default and,
+// if validation fails and a Catch value is set, the fallback value
+func (v *StringValidator) Parse(value string) (string, *Error) {
+	value, errs := v.parse(value, false)
+	if len(errs) > 0 {
+		return value, errs[0]
+	}
+	return value, nil
+}
+
+func (v *StringValidator) parse(value string, all bool) (string, []*Error) {
+	if v.trim {
+		value = strings.TrimSpace(value)
+	}
+
 	// Apply default if value is empty and default is set
-	if v.defaultVal != nil && len(strings.TrimSpace(value)) == 0 {
-		value = *v.defaultVal
+	if len(strings.TrimSpace(value)) == 0 {
+		if v.defaultVal != nil {
+			value = *v.defaultVal
+		} else if v.defaultFn != nil {
+			value = v.defaultFn()
+		}
+	}
+
+	if errs := v.check(value, all); len(errs) > 0 {
+		if v.catchVal != nil {
+			return *v.catchVal, v.check(*v.catchVal, all)
+		}
+		return value, errs
 	}
+	return value, nil
+}
 
-	// Check if required
+// check runs the validation rules against value
+func (v *StringValidator) check(value string, all bool) []*Error {
+	var errs []*Error
+	fail := func(rule string, err *Error) bool {
+		errs = append(errs, v.apply(rule, err))
+		return !all
+	}
+
+	// Check if required; an empty value fails no other rule
 	if v.required && len(strings.TrimSpace(value)) == 0 {
-		return &Error{
+		return []*Error{v.apply("Required", &Error{
 			Code:    "required",
 			Message: "field is required",
-		}
+		})}
 	}
 
 	// If optional and empty, skip validation
@@ -106,46 +377,319 @@ func (v *StringValidator) Validate(value string) *Error {
 	}
 
 	if v.minLen != nil {
-		if len(value) < *v.minLen {
-			return &Error{
+		if v.measure(value) < *v.minLen {
+			if fail("MinLen", &Error{
 				Code:    "too_short",
 				Message: fmt.Sprintf("must be at least %d characters", *v.minLen),
+				Params:  map[string]any{"min": *v.minLen, "actual": v.measure(value)},
+			}) {
+				return errs
 			}
 		}
 	}
 
 	if v.maxLen != nil {
-		if len(value) > *v.maxLen {
-			return &Error{
+		if v.measure(value) > *v.maxLen {
+			if fail("MaxLen", &Error{
 				Code:    "too_long",
 				Message: fmt.Sprintf("must be at most %d characters", *v.maxLen),
+				Params:  map[string]any{"max": *v.maxLen, "actual": v.measure(value)},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.maxBytes != nil {
+		if len(value) > *v.maxBytes {
+			if fail("MaxBytes", &Error{
+				Code:    "too_many_bytes",
+				Message: fmt.Sprintf("must be at most %d bytes", *v.maxBytes),
+				Params:  map[string]any{"max": *v.maxBytes, "actual": len(value)},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.length != nil {
+		if v.measure(value) != *v.length {
+			if fail("Len", &Error{
+				Code:    "wrong_length",
+				Message: fmt.Sprintf("must be exactly %d characters", *v.length),
+				Params:  map[string]any{"length": *v.length, "actual": v.measure(value)},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.prefix != nil {
+		if !strings.HasPrefix(value, *v.prefix) {
+			if fail("StartsWith", &Error{
+				Code:    "missing_prefix",
+				Message: fmt.Sprintf("must start with %q", *v.prefix),
+				Params:  map[string]any{"prefix": *v.prefix},
+			}) {
+				return errs
 			}
 		}
 	}
 
+	if v.suffix != nil {
+		if !strings.HasSuffix(value, *v.suffix) {
+			if fail("EndsWith", &Error{
+				Code:    "missing_suffix",
+				Message: fmt.Sprintf("must end with %q", *v.suffix),
+				Params:  map[string]any{"suffix": *v.suffix},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.contains != nil {
+		if !strings.Contains(value, *v.contains) {
+			if fail("Contains", &Error{
+				Code:    "missing_substring",
+				Message: fmt.Sprintf("must contain %q", *v.contains),
+				Params:  map[string]any{"substring": *v.contains},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.patternErr != nil {
+		if fail("Pattern", &Error{
+			Code:    "invalid_pattern",
+			Message: v.patternErr.Error(),
+		}) {
+			return errs
+		}
+	}
+
 	if v.pattern != nil {
 		if !v.pattern.MatchString(value) {
-			return &Error{
+			if fail("Pattern", &Error{
 				Code:    "invalid_format",
 				Message: "invalid format",
+				Params:  map[string]any{"pattern": v.pattern.String()},
+			}) {
+				return errs
 			}
 		}
 	}
 
-	if v.email {
-		if !strings.Contains(value, "@") || !strings.Contains(value, ".") {
-			return &Error{
+	if v.alpha && !allRunes(value, unicode.IsLetter) {
+		if fail("Alpha", &Error{
+			Code:    "not_alpha",
+			Message: "must contain only letters",
+			Params:  invalidRuneParams(value, unicode.IsLetter),
+		}) {
+			return errs
+		}
+	}
+
+	if v.alnum && !allRunes(value, isAlphanumeric) {
+		if fail("Alphanumeric", &Error{
+			Code:    "not_alphanumeric",
+			Message: "must contain only letters and digits",
+			Params:  invalidRuneParams(value, isAlphanumeric),
+		}) {
+			return errs
+		}
+	}
+
+	if v.numeric && !allRunes(value, isDigit) {
+		if fail("Numeric", &Error{
+			Code:    "not_numeric",
+			Message: "must contain only digits",
+			Params:  invalidRuneParams(value, isDigit),
+		}) {
+			return errs
+		}
+	}
+
+	if v.ascii && !allRunes(value, isASCII) {
+		if fail("ASCII", &Error{
+			Code:    "not_ascii",
+			Message: "must contain only ASCII characters",
+			Params:  invalidRuneParams(value, isASCII),
+		}) {
+			return errs
+		}
+	}
+
+	if v.lower && value != strings.ToLower(value) {
+		if fail("IsLowercase", &Error{
+			Code:    "not_lowercase",
+			Message: "must be lowercase",
+			Params:  invalidRuneParams(value, isLowerOrCaseless),
+		}) {
+			return errs
+		}
+	}
+
+	if v.upper && value != strings.ToUpper(value) {
+		if fail("IsUppercase", &Error{
+			Code:    "not_uppercase",
+			Message: "must be uppercase",
+			Params:  invalidRuneParams(value, isUpperOrCaseless),
+		}) {
+			return errs
+		}
+	}
+
+	if v.printable && !allRunes(value, unicode.IsPrint) {
+		if fail("Printable", &Error{
+			Code:    "not_printable",
+			Message: "must contain only printable characters",
+			Params:  invalidRuneParams(value, unicode.IsPrint),
+		}) {
+			return errs
+		}
+	}
+
+	if v.noControl && !allRunes(value, isNotControl) {
+		if fail("NoControlChars", &Error{
+			Code:    "control_characters",
+			Message: "must not contain control characters",
+			Params:  invalidRuneParams(value, isNotControl),
+		}) {
+			return errs
+		}
+	}
+
+	if v.email != nil {
+		if !isEmail(value, *v.email) {
+			if fail("Email", &Error{
 				Code:    "invalid_email",
 				Message: "must be a valid email address",
+				Params:  map[string]any{"mode": v.email.String()},
+			}) {
+				return errs
 			}
 		}
 	}
 
 	if v.custom != nil {
 		if err := v.custom(value); err != nil {
-			return err
+			if fail("Custom", err) {
+				return errs
+			}
 		}
 	}
 
+	return errs
+}
+
+// StringLikeValidator applies string rules to named string types
+// such as `type Role string`
+type StringLikeValidator[T ~string] struct {
+	validator Validator[string]
+}
+
+// StringLike creates a validator for a named string type that runs the
+// given string validator against the underlying string value
+func StringLike[T ~string](validator Validator[string]) *StringLikeValidator[T] {
+	return &StringLikeValidator[T]{
+		validator: validator,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *StringLikeValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *StringLikeValidator[T]) Validate(value T) *Error {
+	return v.validator.Validate(string(value))
+}
+
+// ValidateAll returns every rule the underlying string violates
+func (v *StringLikeValidator[T]) ValidateAll(value T) []*Error {
+	return validateAll(v.validator, string(value))
+}
+
+// measure returns the length of value in bytes, or in runes if RuneLength is set
+func (v *StringValidator) measure(value string) int {
+	if v.runes {
+		return utf8.RuneCountInString(value)
+	}
+	return len(value)
+}
+
+// allRunes reports whether every rune in s satisfies fn
+// invalidRuneParams describes the first rune of s that valid rejects, as
+// "invalid" and its rune index "position", for the Params of character
+// class rules
+func invalidRuneParams(s string, valid func(rune) bool) map[string]any {
+	position := 0
+	for _, r := range s {
+		if !valid(r) {
+			return map[string]any{"invalid": string(r), "position": position}
+		}
+		position++
+	}
 	return nil
 }
+
+func isLowerOrCaseless(r rune) bool {
+	return unicode.ToLower(r) == r
+}
+
+func isUpperOrCaseless(r rune) bool {
+	return unicode.ToUpper(r) == r
+}
+
+func allRunes(s string, fn func(rune) bool) bool {
+	for _, r := range s {
+		if !fn(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || isDigit(r)
+}
+
+func isNotControl(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' || !unicode.IsControl(r)
+}
+
+func isASCII(r rune) bool {
+	return r <= unicode.MaxASCII
+}
+
+// isEmail reports whether s is an email address under the given mode
+func isEmail(s string, mode EmailMode) bool {
+	if mode != EmailStrict {
+		return strings.Contains(s, "@") && strings.Contains(s, ".")
+	}
+
+	// Reject display names and comments, only bare addresses are accepted
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s {
+		return false
+	}
+
+	at := strings.LastIndex(s, "@")
+	local, domain := s[:at], s[at+1:]
+	if len(s) > 254 || len(local) > 64 || len(domain) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringRuleParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		v     *StringValidator
+		value string
+		want  map[string]any
+	}{
+		{"alpha", String().Alpha(), "ab1c", map[string]any{"invalid": "1", "position": 2}},
+		{"ascii", String().ASCII(), "café", map[string]any{"invalid": "é", "position": 3}},
+		{"numeric", String().Numeric(), "12x", map[string]any{"invalid": "x", "position": 2}},
+		{"lowercase", String().IsLowercase(), "abC", map[string]any{"invalid": "C", "position": 2}},
+		{"email", String().Email(EmailStrict), "nope", map[string]any{"mode": "strict"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Validate(tt.value)
+			if err == nil {
+				t.Fatalf("Validate(%q) passed", tt.value)
+			}
+			if !reflect.DeepEqual(err.Params, tt.want) {
+				t.Errorf("Params = %v, want %v", err.Params, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package validate
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+)
 
 // Struct creates a new schema for validating structs of type T
 func Struct[T any]() *Schema[T] {
@@ -9,49 +12,167 @@ func Struct[T any]() *Schema[T] {
 	}
 }
 
-// Field adds a field validation rule to the schema
+// Field adds a field validation rule to the schema. Selectors returning
+// common field types such as string, int, float64 and time.Time, paired
+// with a validator for that type, are compiled into typed closures so
+// validating them performs no reflection; other rules are adapted with
+// reflection, which the generic Field function avoids for any type.
 func (s *Schema[T]) Field(selector interface{}, validator interface{}) *Schema[T] {
-	// Get the field name from the selector function using reflection
-	t := reflect.TypeOf((*T)(nil)).Elem()
 	selectorVal := reflect.ValueOf(selector)
 
 	if selectorVal.Kind() != reflect.Func {
 		panic("selector must be a function")
 	}
 
-	// Extract field name from the selector
-	fieldName := ""
-	if t.Kind() == reflect.Struct {
-		// Create a zero value of type T
-		var zero T
-		zeroVal := reflect.ValueOf(zero)
-		result := selectorVal.Call([]reflect.Value{zeroVal})[0]
-		selectorType := result.Type()
-
-		for i := 0; i < t.NumField(); i++ {
-			field := t.Field(i)
-			if field.Type == selectorType {
-				fieldName = field.Name
-				break
-			}
+	rule, ok := bindCommon[T](selector, validator)
+	if !ok {
+		rule = FieldRule[T]{
+			// Create a wrapper that converts the field value to any
+			selector: func(t T) any {
+				result := selectorVal.Call([]reflect.Value{reflect.ValueOf(t)})[0]
+				return result.Interface()
+			},
+			adapter:      wrapValidator(validator),
+			fieldOptions: fieldOptions{validator: validator},
 		}
 	}
 
-	// Create a wrapper that converts the field value to any
-	wrapper := func(t T) any {
-		result := selectorVal.Call([]reflect.Value{reflect.ValueOf(t)})[0]
-		return result.Interface()
+	field, resolved := fieldName(rule.selector, selectorVal.Type().Out(0))
+	rule.field = field
+	rule.unresolved = !resolved
+	if resolved {
+		rule.path = field
+	}
+	s.rules = append(s.rules, rule)
+
+	return s
+}
+
+// Field adds a statically typed field validation rule to the schema. Unlike
+// Schema.Field, a mismatch between the selector's result type and the
+// validator is a compile-time error rather than a runtime panic.
+func Field[T, F any](schema *Schema[T], selector func(T) F, rule Validator[F]) *Schema[T] {
+	fieldRule := typedRule(selector, rule)
+	field, resolved := fieldName(fieldRule.selector, reflect.TypeOf((*F)(nil)).Elem())
+	fieldRule.field = field
+	fieldRule.unresolved = !resolved
+	if resolved {
+		fieldRule.path = field
 	}
+	schema.rules = append(schema.rules, fieldRule)
+	return schema
+}
+
+// adapter runs a validator of any type against values boxed in any. The
+// optional hooks are nil when the underlying validator lacks the method.
+type adapter struct {
+	rule Validator[any]
+	// ruleAll runs ValidateAllContext or ValidateAll, used by CollectAll
+	ruleAll func(context.Context, any) []*Error
+	// parse runs Parse, used by Schema.Parse
+	parse func(any) (any, *Error)
+	// ruleCtx runs ValidateContext, used in place of rule when set
+	ruleCtx func(context.Context, any) *Error
+}
 
-	// Create a wrapper that converts the validator to handle any
+// check validates value, returning every violation when all is set and
+// the validator supports it
+func (a *adapter) check(ctx context.Context, value any, all bool) []*Error {
+	if all && a.ruleAll != nil {
+		return a.ruleAll(ctx, value)
+	}
+	var err *Error
+	if a.ruleCtx != nil {
+		err = a.ruleCtx(ctx, value)
+	} else {
+		err = a.rule.Validate(value)
+	}
+	if err == nil {
+		return nil
+	}
+	return []*Error{err}
+}
+
+// invalidType is reported when a value does not match a validator's type
+func invalidType() *Error {
+	return &Error{
+		Code:    "invalid_type",
+		Message: "invalid field type",
+	}
+}
+
+// typedAdapter adapts rule without reflection
+func typedAdapter[F any](rule Validator[F]) adapter {
+	a := adapter{
+		rule: ValidatorFunc[any](func(value any) *Error {
+			if v, ok := value.(F); ok {
+				return rule.Validate(v)
+			}
+			return invalidType()
+		}),
+		ruleAll: func(ctx context.Context, value any) []*Error {
+			if v, ok := value.(F); ok {
+				return validateAllContext(ctx, rule, v)
+			}
+			return []*Error{invalidType()}
+		},
+	}
+	if cv, ok := rule.(ContextValidator[F]); ok {
+		a.ruleCtx = func(ctx context.Context, value any) *Error {
+			if v, ok := value.(F); ok {
+				return cv.ValidateContext(ctx, v)
+			}
+			return invalidType()
+		}
+	}
+	if parser, ok := rule.(Parser[F]); ok {
+		a.parse = func(value any) (any, *Error) {
+			if v, ok := value.(F); ok {
+				return parser.Parse(v)
+			}
+			return value, invalidType()
+		}
+	}
+	return a
+}
+
+// wrapValidator adapts any value with a Validate method using reflection.
+// Values whose type does not match the validator's parameter fail with an
+// invalid_type error.
+func wrapValidator(validator interface{}) adapter {
 	validatorVal := reflect.ValueOf(validator)
 	validateMethod := validatorVal.MethodByName("Validate")
 	if !validateMethod.IsValid() {
 		panic("validator must implement Validate method")
 	}
+	paramType := validateMethod.Type().In(0)
 
-	validatorWrapper := ValidatorFunc[any](func(value any) *Error {
-		result := validateMethod.Call([]reflect.Value{reflect.ValueOf(value)})
+	argument := func(value any) (reflect.Value, *Error) {
+		if value == nil {
+			return reflect.Zero(paramType), nil
+		}
+		arg := reflect.ValueOf(value)
+		// pointer fields are dereferenced for validators of the pointed-to
+		// type, such as Nested on an optional sub-object
+		if arg.Kind() == reflect.Pointer && !arg.Type().AssignableTo(paramType) && arg.Type().Elem().AssignableTo(paramType) {
+			if arg.IsNil() {
+				return reflect.Zero(paramType), nil
+			}
+			arg = arg.Elem()
+		}
+		if !arg.Type().AssignableTo(paramType) {
+			return arg, invalidType()
+		}
+		return arg, nil
+	}
+
+	var a adapter
+	a.rule = ValidatorFunc[any](func(value any) *Error {
+		arg, err := argument(value)
+		if err != nil {
+			return err
+		}
+		result := validateMethod.Call([]reflect.Value{arg})
 		if len(result) != 1 {
 			panic("Validate method must return exactly one value")
 		}
@@ -61,13 +182,55 @@ func (s *Schema[T]) Field(selector interface{}, validator interface{}) *Schema[T
 		return result[0].Interface().(*Error)
 	})
 
-	s.rules = append(s.rules, FieldRule[T]{
-		selector: wrapper,
-		rule:     validatorWrapper,
-		field:    fieldName,
-	})
+	if allCtxMethod := validatorVal.MethodByName("ValidateAllContext"); allCtxMethod.IsValid() && allCtxMethod.Type().NumIn() == 2 {
+		a.ruleAll = func(ctx context.Context, value any) []*Error {
+			arg, err := argument(value)
+			if err != nil {
+				return []*Error{err}
+			}
+			result := allCtxMethod.Call([]reflect.Value{reflect.ValueOf(&ctx).Elem(), arg})
+			errs, _ := result[0].Interface().([]*Error)
+			return errs
+		}
+	} else if validateAllMethod := validatorVal.MethodByName("ValidateAll"); validateAllMethod.IsValid() {
+		a.ruleAll = func(_ context.Context, value any) []*Error {
+			arg, err := argument(value)
+			if err != nil {
+				return []*Error{err}
+			}
+			result := validateAllMethod.Call([]reflect.Value{arg})
+			errs, _ := result[0].Interface().([]*Error)
+			return errs
+		}
+	}
 
-	return s
+	if ctxMethod := validatorVal.MethodByName("ValidateContext"); ctxMethod.IsValid() && ctxMethod.Type().NumIn() == 2 {
+		a.ruleCtx = func(ctx context.Context, value any) *Error {
+			arg, err := argument(value)
+			if err != nil {
+				return err
+			}
+			result := ctxMethod.Call([]reflect.Value{reflect.ValueOf(&ctx).Elem(), arg})
+			ctxErr, _ := result[0].Interface().(*Error)
+			return ctxErr
+		}
+	}
+
+	parseMethod := validatorVal.MethodByName("Parse")
+	if parseMethod.IsValid() && parseMethod.Type().NumIn() == 1 && parseMethod.Type().NumOut() == 2 &&
+		parseMethod.Type().Out(0) == paramType && parseMethod.Type().Out(1) == reflect.TypeOf((*Error)(nil)) {
+		a.parse = func(value any) (any, *Error) {
+			arg, err := argument(value)
+			if err != nil {
+				return value, err
+			}
+			result := parseMethod.Call([]reflect.Value{arg})
+			parseErr, _ := result[1].Interface().(*Error)
+			return result[0].Interface(), parseErr
+		}
+	}
+
+	return a
 }
 
 // ValidatorFunc is a helper type that allows functions to implement Validator
@@ -88,10 +251,7 @@ func TypedField[T, F any](selector func(T) F, rule Validator[F]) (func(T) any, V
 		if v, ok := value.(F); ok {
 			return rule.Validate(v)
 		}
-		return &Error{
-			Code:    "invalid_type",
-			Message: "invalid field type",
-		}
+		return invalidType()
 	})
 
 	return wrapper, validatorWrapper
@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SwitchValidator validates polymorphic values, such as payment methods or
+// webhook events, against the schema selected by a discriminator
+type SwitchValidator[T any] struct {
+	discriminator func(T) string
+	cases         map[string]*Schema[T]
+	fallback      *Schema[T]
+}
+
+var _ ContextValidator[struct{}] = (*SwitchValidator[struct{}])(nil)
+
+// Switch creates a discriminated union validator. The discriminator returns
+// the variant name of a value and Case registers the schema for each name.
+func Switch[T any](discriminator func(T) string) *SwitchValidator[T] {
+	return &SwitchValidator[T]{
+		discriminator: discriminator,
+		cases:         make(map[string]*Schema[T]),
+	}
+}
+
+// Case validates values whose discriminator is name against schema
+func (v *SwitchValidator[T]) Case(name string, schema *Schema[T]) *SwitchValidator[T] {
+	v.cases[name] = schema
+	return v
+}
+
+// Default validates values whose discriminator matches no case against
+// schema instead of rejecting them
+func (v *SwitchValidator[T]) Default(schema *Schema[T]) *SwitchValidator[T] {
+	v.fallback = schema
+	return v
+}
+
+// Compile reports misconfiguration of any case schema
+func (v *SwitchValidator[T]) Compile() error {
+	for _, name := range v.names() {
+		if err := v.cases[name].Compile(); err != nil {
+			return fmt.Errorf("case %s: %w", name, err)
+		}
+	}
+	if v.fallback != nil {
+		if err := v.fallback.Compile(); err != nil {
+			return fmt.Errorf("default case: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate implements the Validator interface
+func (v *SwitchValidator[T]) Validate(value T) *Error {
+	return v.ValidateContext(context.Background(), value)
+}
+
+// ValidateAll returns every error reported by the selected case schema,
+// excluding warnings
+func (v *SwitchValidator[T]) ValidateAll(value T) []*Error {
+	return v.check(context.Background(), value)
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *SwitchValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	if errs := v.check(ctx, value); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// check validates value against the schema its discriminator selects
+func (v *SwitchValidator[T]) check(ctx context.Context, value T) []*Error {
+	name := v.discriminator(value)
+	schema, ok := v.cases[name]
+	if !ok {
+		schema = v.fallback
+	}
+	if schema == nil {
+		return []*Error{{
+			Code:    "invalid_discriminator",
+			Message: fmt.Sprintf("unknown variant %q, must be one of: %s", name, strings.Join(v.names(), ", ")),
+		}}
+	}
+	return schema.ValidateContext(ctx, value).failures()
+}
+
+// names returns the case names in sorted order
+func (v *SwitchValidator[T]) names() []string {
+	names := make([]string, 0, len(v.cases))
+	for name := range v.cases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,16 +1,33 @@
 package validate
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
 // TimeValidator validates time.Time values
 type TimeValidator struct {
+	overrides
 	after    *time.Time
 	before   *time.Time
 	between  *[2]time.Time
+	minAge   *int
+	maxAge   *int
+	past     *time.Duration
+	next     *time.Duration
+	weekdays []time.Weekday
+	excluded []time.Weekday
 	custom   func(time.Time) *Error
 	required bool
+	now      func() time.Time
+	// afterNow, beforeNow and today are set by Future, Past and Today and
+	// read the clock at validation time
+	afterNow  bool
+	beforeNow bool
+	today     bool
 }
 
 var _ Validator[time.Time] = (*TimeValidator)(nil)
@@ -23,42 +40,151 @@ func Time() *TimeValidator {
 // After adds validation that time must be after the given time
 func (v *TimeValidator) After(t time.Time) *TimeValidator {
 	v.after = &t
+	v.rule("After")
 	return v
 }
 
 // Before adds validation that time must be before the given time
 func (v *TimeValidator) Before(t time.Time) *TimeValidator {
 	v.before = &t
+	v.rule("Before")
 	return v
 }
 
 // Between adds validation that time must be between two times
 func (v *TimeValidator) Between(start, end time.Time) *TimeValidator {
 	v.between = &[2]time.Time{start, end}
+	v.rule("Between")
+	return v
+}
+
+// Weekdays requires the time to fall on one of the given days, e.g. for a
+// Sunday-Thursday working week
+func (v *TimeValidator) Weekdays(days ...time.Weekday) *TimeValidator {
+	v.weekdays = slices.Clone(days)
+	v.rule("Weekdays")
+	return v
+}
+
+// NotWeekdays requires the time not to fall on any of the given days.
+// Repeated calls add to the excluded days, so it combines with BusinessDay.
+func (v *TimeValidator) NotWeekdays(days ...time.Weekday) *TimeValidator {
+	v.excluded = slices.Concat(v.excluded, days)
+	v.rule("NotWeekdays")
+	return v
+}
+
+// MinAge treats the value as a birthdate and requires an age of at least
+// the given number of years relative to the current time
+func (v *TimeValidator) MinAge(years int) *TimeValidator {
+	v.minAge = &years
+	v.rule("MinAge")
+	return v
+}
+
+// MaxAge treats the value as a birthdate and requires an age of at most
+// the given number of years relative to the current time
+func (v *TimeValidator) MaxAge(years int) *TimeValidator {
+	v.maxAge = &years
+	v.rule("MaxAge")
+	return v
+}
+
+// WithinPast requires the time to fall within the given duration before
+// now, where now is evaluated at validation time
+func (v *TimeValidator) WithinPast(d time.Duration) *TimeValidator {
+	v.past = &d
+	v.rule("WithinPast")
+	return v
+}
+
+// WithinNext requires the time to fall within the given duration after
+// now, where now is evaluated at validation time
+func (v *TimeValidator) WithinNext(d time.Duration) *TimeValidator {
+	v.next = &d
+	v.rule("WithinNext")
+	return v
+}
+
+// Clock sets the function used to obtain the current time for relative
+// rules such as MinAge and WithinPast, which is useful for deterministic tests
+func (v *TimeValidator) Clock(now func() time.Time) *TimeValidator {
+	v.now = now
 	return v
 }
 
 // Custom adds a custom validation function
 func (v *TimeValidator) Custom(fn func(time.Time) *Error) *TimeValidator {
 	v.custom = fn
+	v.rule("Custom")
 	return v
 }
 
 // Required marks the field as required
 func (v *TimeValidator) Required() *TimeValidator {
 	v.required = true
+	v.rule("Required")
+	return v
+}
+
+// WithMessage replaces the message of the most recently added rule, e.g.
+// Future().WithMessage("Pick a date in the future")
+func (v *TimeValidator) WithMessage(message string) *TimeValidator {
+	v.setMessage(message)
 	return v
 }
 
+// WithCode replaces the error code of the most recently added rule
+func (v *TimeValidator) WithCode(code string) *TimeValidator {
+	v.setCode(code)
+	return v
+}
+
+// Compile reports rule combinations no time can satisfy, such as an After
+// bound that is not before the Before bound
+func (v *TimeValidator) Compile() error {
+	switch {
+	case v.after != nil && v.before != nil && !v.after.Before(*v.before):
+		return fmt.Errorf("after %s is not before %s", v.after.Format(time.RFC3339), v.before.Format(time.RFC3339))
+	case v.between != nil && v.between[0].After(v.between[1]):
+		return fmt.Errorf("between bounds %s and %s are reversed", v.between[0].Format(time.RFC3339), v.between[1].Format(time.RFC3339))
+	case v.afterNow && v.beforeNow:
+		return errors.New("time cannot be both in the future and in the past")
+	case v.minAge != nil && v.maxAge != nil && *v.minAge > *v.maxAge:
+		return fmt.Errorf("min age %d is greater than max age %d", *v.minAge, *v.maxAge)
+	}
+	return nil
+}
+
 // Validate validates a time value
 func (v *TimeValidator) Validate(value time.Time) *Error {
+	if errs := v.check(value, false); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateAll returns every rule the value violates instead of stopping
+// at the first
+func (v *TimeValidator) ValidateAll(value time.Time) []*Error {
+	return v.check(value, true)
+}
+
+// check runs the validation rules against value
+func (v *TimeValidator) check(value time.Time, all bool) []*Error {
+	var errs []*Error
+	fail := func(rule string, err *Error) bool {
+		errs = append(errs, v.apply(rule, err))
+		return !all
+	}
+
 	// Check if required
 	if v.required && value.IsZero() {
-		return &Error{
+		return []*Error{v.apply("Required", &Error{
 			Field:   "",
 			Code:    "required",
 			Message: "field is required",
-		}
+		})}
 	}
 
 	// Skip validation for zero time if not required
@@ -68,19 +194,61 @@ func (v *TimeValidator) Validate(value time.Time) *Error {
 
 	// Check after constraint
 	if v.after != nil && !value.After(*v.after) {
-		return &Error{
+		if fail("After", &Error{
 			Field:   "",
 			Code:    "too_early",
 			Message: "time must be after " + v.after.Format(time.RFC3339),
+			Params:  map[string]any{"after": *v.after, "actual": value},
+		}) {
+			return errs
 		}
 	}
 
 	// Check before constraint
 	if v.before != nil && !value.Before(*v.before) {
-		return &Error{
+		if fail("Before", &Error{
 			Field:   "",
 			Code:    "too_late",
 			Message: "time must be before " + v.before.Format(time.RFC3339),
+			Params:  map[string]any{"before": *v.before, "actual": value},
+		}) {
+			return errs
+		}
+	}
+
+	// Check constraints relative to the current time
+	if v.afterNow || v.beforeNow || v.today {
+		now := v.currentTime()
+		if v.afterNow && !value.After(now) {
+			if fail("Future", &Error{
+				Code:    "too_early",
+				Message: "time must be in the future",
+				Params:  map[string]any{"after": now, "actual": value},
+			}) {
+				return errs
+			}
+		}
+		if v.beforeNow && !value.Before(now) {
+			if fail("Past", &Error{
+				Code:    "too_late",
+				Message: "time must be in the past",
+				Params:  map[string]any{"before": now, "actual": value},
+			}) {
+				return errs
+			}
+		}
+		if v.today {
+			y, m, d := now.Date()
+			start := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+			if value.Before(start) || !value.Before(start.AddDate(0, 0, 1)) {
+				if fail("Today", &Error{
+					Code:    "out_of_range",
+					Message: "time must be today",
+					Params:  map[string]any{"start": start, "end": start.AddDate(0, 0, 1), "actual": value},
+				}) {
+					return errs
+				}
+			}
 		}
 	}
 
@@ -88,10 +256,90 @@ func (v *TimeValidator) Validate(value time.Time) *Error {
 	if v.between != nil {
 		start, end := v.between[0], v.between[1]
 		if value.Before(start) || value.After(end) {
-			return &Error{
+			if fail("Between", &Error{
 				Field:   "",
 				Code:    "out_of_range",
 				Message: "time must be between " + start.Format(time.RFC3339) + " and " + end.Format(time.RFC3339),
+				Params:  map[string]any{"start": start, "end": end, "actual": value},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	// Check weekday constraints
+	if len(v.weekdays) > 0 && !containsWeekday(v.weekdays, value.Weekday()) {
+		if fail("Weekdays", &Error{
+			Field:   "",
+			Code:    "invalid_weekday",
+			Message: "must be on " + joinWeekdays(v.weekdays),
+			Params:  map[string]any{"weekdays": joinWeekdays(v.weekdays), "actual": value.Weekday().String()},
+		}) {
+			return errs
+		}
+	}
+
+	if containsWeekday(v.excluded, value.Weekday()) {
+		if fail("NotWeekdays", &Error{
+			Field:   "",
+			Code:    "invalid_weekday",
+			Message: "must not be on " + joinWeekdays(v.excluded),
+			Params:  map[string]any{"excluded": joinWeekdays(v.excluded), "actual": value.Weekday().String()},
+		}) {
+			return errs
+		}
+	}
+
+	// Check relative window constraints
+	if v.past != nil {
+		now := v.currentTime()
+		if value.Before(now.Add(-*v.past)) || value.After(now) {
+			if fail("WithinPast", &Error{
+				Field:   "",
+				Code:    "out_of_range",
+				Message: "time must be within the last " + v.past.String(),
+				Params:  map[string]any{"within": v.past.String(), "actual": value},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	if v.next != nil {
+		now := v.currentTime()
+		if value.Before(now) || value.After(now.Add(*v.next)) {
+			if fail("WithinNext", &Error{
+				Field:   "",
+				Code:    "out_of_range",
+				Message: "time must be within the next " + v.next.String(),
+				Params:  map[string]any{"within": v.next.String(), "actual": value},
+			}) {
+				return errs
+			}
+		}
+	}
+
+	// Check age constraints
+	if v.minAge != nil || v.maxAge != nil {
+		age := ageAt(value, v.currentTime())
+		if v.minAge != nil && age < *v.minAge {
+			if fail("MinAge", &Error{
+				Field:   "",
+				Code:    "too_young",
+				Message: fmt.Sprintf("must be at least %d years old", *v.minAge),
+				Params:  map[string]any{"min": *v.minAge, "actual": age},
+			}) {
+				return errs
+			}
+		}
+		if v.maxAge != nil && age > *v.maxAge {
+			if fail("MaxAge", &Error{
+				Field:   "",
+				Code:    "too_old",
+				Message: fmt.Sprintf("must be at most %d years old", *v.maxAge),
+				Params:  map[string]any{"max": *v.maxAge, "actual": age},
+			}) {
+				return errs
 			}
 		}
 	}
@@ -99,39 +347,77 @@ func (v *TimeValidator) Validate(value time.Time) *Error {
 	// Check custom validation
 	if v.custom != nil {
 		if err := v.custom(value); err != nil {
-			return err
+			if fail("Custom", err) {
+				return errs
+			}
 		}
 	}
 
-	return nil
+	return errs
+}
+
+// currentTime returns the time from the configured clock or time.Now
+func (v *TimeValidator) currentTime() time.Time {
+	if v.now != nil {
+		return v.now()
+	}
+	return time.Now()
+}
+
+// ageAt returns the number of whole years between birth and now. The
+// birthdate is a calendar date, so it is compared as given rather than
+// converted to now's location, which could move it to another day.
+func ageAt(birth, now time.Time) int {
+	age := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || (now.Month() == birth.Month() && now.Day() < birth.Day()) {
+		age--
+	}
+	return age
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func joinWeekdays(days []time.Weekday) string {
+	names := make([]string, len(days))
+	for i, d := range days {
+		names[i] = d.String()
+	}
+	return strings.Join(names, ", ")
 }
 
-// Common time validation helpers
+// Today requires the time to fall on the current day in the clock's
+// location, evaluated on every call
 func (v *TimeValidator) Today() *TimeValidator {
-	now := time.Now()
-	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	end := start.Add(24 * time.Hour)
-	return v.Between(start, end)
+	v.today = true
+	v.rule("Today")
+	return v
 }
 
+// Future requires the time to be after the current time, evaluated on
+// every call
 func (v *TimeValidator) Future() *TimeValidator {
-	return v.After(time.Now())
+	v.afterNow = true
+	v.rule("Future")
+	return v
 }
 
+// Past requires the time to be before the current time, evaluated on
+// every call
 func (v *TimeValidator) Past() *TimeValidator {
-	return v.Before(time.Now())
+	v.beforeNow = true
+	v.rule("Past")
+	return v
 }
 
+// BusinessDay requires a Monday-Friday time; it is shorthand for
+// NotWeekdays(time.Saturday, time.Sunday) and leaves Custom free
 func (v *TimeValidator) BusinessDay() *TimeValidator {
-	return v.Custom(func(t time.Time) *Error {
-		weekday := t.Weekday()
-		if weekday == time.Saturday || weekday == time.Sunday {
-			return &Error{
-				Field:   "",
-				Code:    "not_business_day",
-				Message: "must be a business day (Monday-Friday)",
-			}
-		}
-		return nil
-	})
+	return v.NotWeekdays(time.Saturday, time.Sunday)
 }
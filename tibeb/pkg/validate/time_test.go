@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMinAgeBehindUTC(t *testing.T) {
+	newYork := time.FixedZone("EDT", -4*60*60)
+	now := time.Date(2026, 10, 16, 20, 0, 0, 0, newYork)
+	adult := Date("2006-01-02").MinAge(18).Clock(func() time.Time { return now })
+
+	if err := adult.Validate("2008-10-17"); err == nil {
+		t.Error("MinAge(18) accepted someone turning 18 tomorrow")
+	}
+	if err := adult.Validate("2008-10-16"); err != nil {
+		t.Errorf("MinAge(18) rejected someone turning 18 today: %v", err)
+	}
+}
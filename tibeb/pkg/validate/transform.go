@@ -2,6 +2,7 @@ package validate
 
 import (
 	"encoding/json"
+	"errors"
 	"strconv"
 	"strings"
 	"time"
@@ -13,12 +14,21 @@ type TransformFunc[T any] func(T) T
 // ParseFunc represents a parsing function that can fail
 type ParseFunc[T, U any] func(T) (U, error)
 
-// TransformValidator wraps another validator with transformations
+// TransformValidator wraps another validator with transformations. A
+// value goes through the pipeline in this order:
+//
+//  1. the default replaces a zero value (DefaultBefore, the default)
+//  2. the transforms run in the order they were added
+//  3. the default replaces a zero result instead, with DefaultAfter
+//  4. the wrapped validator runs
+//  5. the Catch value replaces a value that failed validation
 type TransformValidator[T any] struct {
-	validator  Validator[T]
-	transforms []TransformFunc[T]
-	defaultVal *T
-	catchVal   *T
+	validator    Validator[T]
+	transforms   []TransformFunc[T]
+	defaultVal   *T
+	defaultFn    func() T
+	defaultAfter bool
+	catchVal     *T
 }
 
 var _ Validator[string] = (*TransformValidator[string])(nil)
@@ -39,6 +49,18 @@ func (v *IntValidator) Transform(fn func(int) int) *TransformValidator[int] {
 	}
 }
 
+// Transformed wraps any validator with transformations applied before it
+// runs, so time, float, slice and custom validators can use Default, Catch
+// and Parse like String().Transform does, e.g.
+//
+//	validate.Transformed[time.Time](validate.Time().Past(), func(t time.Time) time.Time { return t.UTC() })
+func Transformed[T any](validator Validator[T], fns ...TransformFunc[T]) *TransformValidator[T] {
+	return &TransformValidator[T]{
+		validator:  validator,
+		transforms: fns,
+	}
+}
+
 // Pipe adds another transformation to the chain
 func (v *TransformValidator[T]) Pipe(fn TransformFunc[T]) *TransformValidator[T] {
 	v.transforms = append(v.transforms, fn)
@@ -48,6 +70,31 @@ func (v *TransformValidator[T]) Pipe(fn TransformFunc[T]) *TransformValidator[T]
 // Default sets a default value to use if the input is zero/empty
 func (v *TransformValidator[T]) Default(val T) *TransformValidator[T] {
 	v.defaultVal = &val
+	v.defaultFn = nil
+	return v
+}
+
+// DefaultFunc sets a function called at validation time for the value to
+// use if the input is zero/empty, so defaults such as time.Now are not
+// frozen when the schema is built
+func (v *TransformValidator[T]) DefaultFunc(fn func() T) *TransformValidator[T] {
+	v.defaultFn = fn
+	v.defaultVal = nil
+	return v
+}
+
+// DefaultBefore applies the default to zero input before the transforms
+// run. This is the default order.
+func (v *TransformValidator[T]) DefaultBefore() *TransformValidator[T] {
+	v.defaultAfter = false
+	return v
+}
+
+// DefaultAfter applies the default to a zero result of the transforms
+// instead of to zero input, so Trim().Default("x").DefaultAfter() turns
+// all-whitespace input into "x"
+func (v *TransformValidator[T]) DefaultAfter() *TransformValidator[T] {
+	v.defaultAfter = true
 	return v
 }
 
@@ -57,26 +104,72 @@ func (v *TransformValidator[T]) Catch(val T) *TransformValidator[T] {
 	return v
 }
 
+// Compile reports misconfiguration of the wrapped validator
+func (v *TransformValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
 // Validate applies transformations then validates
 func (v *TransformValidator[T]) Validate(value T) *Error {
-	if v.defaultVal != nil && isZeroValue(value) {
-		value = *v.defaultVal
+	_, err := v.Parse(value)
+	return err
+}
+
+// ValidateAll applies transformations then returns every rule the value
+// violates
+func (v *TransformValidator[T]) ValidateAll(value T) []*Error {
+	value = v.apply(value)
+	if errs := validateAll(v.validator, value); len(errs) > 0 {
+		if v.catchVal != nil {
+			return validateAll(v.validator, *v.catchVal)
+		}
+		return errs
 	}
+	return nil
+}
 
-	// Apply all transformations in order
+// apply applies the default and all transformations in pipeline order
+func (v *TransformValidator[T]) apply(value T) T {
+	if !v.defaultAfter {
+		value = v.withDefault(value)
+	}
 	for _, transform := range v.transforms {
 		value = transform(value)
 	}
+	if v.defaultAfter {
+		value = v.withDefault(value)
+	}
+	return value
+}
+
+// withDefault returns the default for a zero value and value otherwise
+func (v *TransformValidator[T]) withDefault(value T) T {
+	if !isZeroValue(value) {
+		return value
+	}
+	if v.defaultVal != nil {
+		return *v.defaultVal
+	}
+	if v.defaultFn != nil {
+		return v.defaultFn()
+	}
+	return value
+}
+
+// Parse applies the default and transformations, validates the result and
+// returns the transformed value, or the Catch value if validation fails
+func (v *TransformValidator[T]) Parse(value T) (T, *Error) {
+	value = v.apply(value)
 
 	// Validate the transformed value
 	if err := v.validator.Validate(value); err != nil {
 		if v.catchVal != nil {
-			return v.validator.Validate(*v.catchVal)
+			return *v.catchVal, v.validator.Validate(*v.catchVal)
 		}
-		return err
+		return value, err
 	}
 
-	return nil
+	return value, nil
 }
 
 // Common string transformations
@@ -99,6 +192,8 @@ func (v *StringValidator) Uppercase() *TransformValidator[string] {
 type ParseValidator[T, U any] struct {
 	parseFunc ParseFunc[T, U]
 	validator Validator[U]
+	code      string
+	message   string
 }
 
 // Parse creates a new parse validator
@@ -109,6 +204,31 @@ func Parse[T, U any](parseFunc ParseFunc[T, U], validator Validator[U]) *ParseVa
 	}
 }
 
+// OnError replaces the code and message reported when parsing fails, so
+// raw parser text such as strconv errors does not reach clients, e.g.
+// OnError("invalid_number", "must be a whole number"). The parser's error
+// remains available as the Cause.
+func (v *ParseValidator[T, U]) OnError(code, message string) *ParseValidator[T, U] {
+	v.code = code
+	v.message = message
+	return v
+}
+
+// ParseThen chains a further parse onto first: the value first parses and
+// validates is parsed again by parse and validated by validator, e.g.
+// string → int → Weekday. Errors of the earlier stage are reported as
+// they are.
+func ParseThen[T, U, V any](first *ParseValidator[T, U], parse ParseFunc[U, V], validator Validator[V]) *ParseValidator[T, V] {
+	return Parse(func(value T) (V, error) {
+		parsed, err := first.Convert(value)
+		if err != nil {
+			var zero V
+			return zero, err
+		}
+		return parse(parsed)
+	}, validator)
+}
+
 // Common parse functions for strings
 func (v *StringValidator) ParseInt() *ParseValidator[string, int] {
 	return Parse(func(s string) (int, error) {
@@ -131,19 +251,37 @@ func (v *StringValidator) ParseJSON(target interface{}) *ParseValidator[string,
 
 // Validate for ParseValidator
 func (v *ParseValidator[T, U]) Validate(value T) *Error {
+	_, err := v.Convert(value)
+	return err
+}
+
+// Convert parses and validates value, returning the parsed value
+func (v *ParseValidator[T, U]) Convert(value T) (U, *Error) {
 	parsed, err := v.parseFunc(value)
 	if err != nil {
-		return &Error{
+		var stageErr *Error
+		if errors.As(err, &stageErr) {
+			return parsed, stageErr
+		}
+		parseErr := &Error{
 			Field:   "",
 			Code:    "parse_error",
 			Message: "failed to parse value: " + err.Error(),
+			Cause:   err,
+		}
+		if v.code != "" {
+			parseErr.Code = v.code
+			parseErr.Message = v.message
+			parseErr.fixed = true
 		}
+		return parsed, parseErr
 	}
 
-	return v.validator.Validate(parsed)
+	return parsed, v.validator.Validate(parsed)
 }
 
+// isZeroValue reports whether value is the zero value of T, including for
+// types such as slices that cannot be compared with ==
 func isZeroValue[T any](value T) bool {
-	var zero T
-	return any(value) == any(zero)
+	return isZeroAny(value)
 }
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"context"
+	"time"
+)
+
+// binder returns a reflection-free rule for a selector and validator of
+// one specific field type, leaving the field name unset
+type binder[T any] func(selector, validator any) (FieldRule[T], bool)
+
+// bindTyped binds selector and validator when they are a func(T) F and a
+// Validator[F], so validating the field needs no reflect calls
+func bindTyped[T, F any](selector, validator any) (FieldRule[T], bool) {
+	sel, ok := selector.(func(T) F)
+	if !ok {
+		return FieldRule[T]{}, false
+	}
+	rule, ok := validator.(Validator[F])
+	if !ok {
+		return FieldRule[T]{}, false
+	}
+	return typedRule(sel, rule), true
+}
+
+// typedRule builds the rule for a typed selector and validator. Besides
+// the boxing selector and adapter every rule has, it sets direct, which
+// keeps F unboxed so passing fields validate without allocating.
+func typedRule[T, F any](selector func(T) F, rule Validator[F]) FieldRule[T] {
+	cv, _ := rule.(ContextValidator[F])
+	return FieldRule[T]{
+		selector:     func(t T) any { return selector(t) },
+		adapter:      typedAdapter(rule),
+		fieldOptions: fieldOptions{validator: rule},
+		direct: func(ctx context.Context, t T) (*Error, bool) {
+			value, ok := selectValue(selector, t)
+			if !ok {
+				return nil, false
+			}
+			if cv != nil {
+				return cv.ValidateContext(ctx, value), true
+			}
+			return rule.Validate(value), true
+		},
+	}
+}
+
+// bindCommon compiles the rules Schema.Field adds for the field types
+// built-in validators handle into typed closures. Other field types fall
+// back to reflection; use the generic Field function to avoid it for them.
+func bindCommon[T any](selector, validator any) (FieldRule[T], bool) {
+	for _, bind := range []binder[T]{
+		bindTyped[T, string],
+		bindTyped[T, int],
+		bindTyped[T, int64],
+		bindTyped[T, float64],
+		bindTyped[T, bool],
+		bindTyped[T, time.Time],
+		bindTyped[T, []string],
+		bindTyped[T, []int],
+		bindTyped[T, map[string]any],
+	} {
+		if rule, ok := bind(selector, validator); ok {
+			return rule, true
+		}
+	}
+	return FieldRule[T]{}, false
+}
@@ -1,10 +1,49 @@
 package validate
 
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
 // Error represents a validation error
 type Error struct {
 	Field   string `json:"field,omitempty"`
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	// Params holds the rule's parameters and the offending measurement,
+	// e.g. {"min": 3, "actual": 2}, so clients can render their own messages
+	Params map[string]any `json:"params,omitempty"`
+	// Severity is SeverityWarning for non-fatal rules; the zero value is an
+	// error
+	Severity Severity `json:"severity,omitempty"`
+	// Cause is the underlying error, such as a strconv, database or network
+	// failure, when the rule failed for a reason other than the value
+	Cause error `json:"-"`
+	// Path is the structured location of the error; Field is its dotted form
+	Path Path `json:"-"`
+	// fixed marks a message given explicitly, e.g. with WithMessage, which
+	// translation leaves as it is
+	fixed bool
+	// label is the field's Label, used in place of Field by translations
+	label string
+}
+
+// Severity distinguishes hard failures from non-fatal warnings
+type Severity string
+
+const (
+	// SeverityError marks a hard failure; it is the default
+	SeverityError Severity = "error"
+	// SeverityWarning marks a non-fatal finding, such as use of a
+	// deprecated field, that should not block the request
+	SeverityWarning Severity = "warning"
+)
+
+// IsWarning reports whether the error is a non-fatal warning
+func (e *Error) IsWarning() bool {
+	return e.Severity == SeverityWarning
 }
 
 // Errors represents a collection of validation errors
@@ -20,12 +59,36 @@ func (e *Errors) Add(err *Error) {
 	e.errors = append(e.errors, err)
 }
 
-// HasErrors returns true if there are any validation errors
+// HasErrors returns true if there are any validation errors other than
+// warnings
 func (e *Errors) HasErrors() bool {
-	return len(e.errors) > 0
+	for _, err := range e.errors {
+		if !err.IsWarning() {
+			return true
+		}
+	}
+	return false
 }
 
-// Get returns all validation errors
+// HasWarnings returns true if there are any warnings
+func (e *Errors) HasWarnings() bool {
+	return len(e.Warnings()) > 0
+}
+
+// Warnings returns the non-fatal errors reported by rules marked with Warn
+func (e *Errors) Warnings() []*Error {
+	var warnings []*Error
+	for _, err := range e.errors {
+		if err.IsWarning() {
+			warnings = append(warnings, err)
+		}
+	}
+	return warnings
+}
+
+// Get returns all validation errors, including warnings. Errors are in a
+// stable order: field rules in the order they were added to the schema,
+// then refinements; use Sort to order them by path instead.
 func (e *Errors) Get() []*Error {
 	return e.errors
 }
@@ -35,26 +98,594 @@ type Validator[T any] interface {
 	Validate(value T) *Error
 }
 
-// Schema represents a validation schema for a struct
+// MultiValidator is implemented by validators that can report every rule a
+// value violates in one pass rather than only the first
+type MultiValidator[T any] interface {
+	ValidateAll(value T) []*Error
+}
+
+// validateAll runs every rule of v against value when v supports it and
+// falls back to the single error from Validate otherwise
+func validateAll[T any](v Validator[T], value T) []*Error {
+	if m, ok := v.(MultiValidator[T]); ok {
+		return m.ValidateAll(value)
+	}
+	if err := v.Validate(value); err != nil {
+		return []*Error{err}
+	}
+	return nil
+}
+
+// Parser is implemented by validators that can return the value they
+// validated after applying defaults, transforms and catch fallbacks
+type Parser[T any] interface {
+	Parse(value T) (T, *Error)
+}
+
+// Compiler is implemented by validators that can detect misconfiguration
+// (such as invalid regular expressions) before any value is validated
+type Compiler interface {
+	Compile() error
+}
+
+// compileValidator compiles v if it implements Compiler
+func compileValidator(v any) error {
+	if c, ok := v.(Compiler); ok {
+		return c.Compile()
+	}
+	return nil
+}
+
+// Schema represents a validation schema for a struct.
+//
+// Builder methods such as Field, Refine and Label modify the receiver and
+// return it. To derive variants from a shared base, start from a copy
+// made by Clone, Extend, Pick, Omit or Partial; copies never share rules
+// with their source, so building one does not affect the other. Once
+// built, a schema is safe for concurrent use by multiple goroutines, since
+// validation never modifies it; builder methods must not run concurrently
+// with validation.
 type Schema[T any] struct {
-	rules []FieldRule[T]
+	rules       []FieldRule[T]
+	refinements []func(T) *Error
+	collectAll  bool
+	jsonNames   bool
+	strict      bool
+	failFast    bool
+	locale      string
+	maxErrors   int
+	// updates are RefineUpdate rules, run only by ValidateUpdate
+	updates []func(old, new T) *Error
+	// preprocess runs on the whole value before any rule; see Preprocess
+	preprocess []func(T) T
+	// after runs once validation finishes; see AfterValidate
+	after []func(T, *Errors)
+	// parallel is the goroutine limit set by Parallel
+	parallel int
 }
 
 // FieldRule represents a validation rule for a struct field
 type FieldRule[T any] struct {
 	selector func(T) any
-	rule     Validator[any]
-	field    string
+	adapter
+	// direct selects and validates the field without boxing it in an
+	// any, reporting false when the selector dereferenced a nil pointer.
+	// It is set for typed rules and used when no option needs the boxed
+	// value.
+	direct func(context.Context, T) (*Error, bool)
+	// when skips the rule unless it reports true for the whole value
+	when func(T) bool
+	// requiredIf makes a zero field an error when it reports true and
+	// skips the rule for a zero field otherwise
+	requiredIf func(T) bool
+	// path is the dotted path of the field within T that Parse writes
+	// parsed values back to, or empty when it could not be resolved, in
+	// which case parsed values are not written back
+	path string
+	fieldOptions
+}
+
+// fieldOptions holds the parts of a FieldRule that do not depend on the
+// struct type, so ExtendWith can carry them over to rules of another type
+type fieldOptions struct {
+	validator any
+	field     string
+	// unresolved is set when field was guessed from the selector's type
+	// because probing could not identify it
+	unresolved bool
+	// label replaces the field name at the start of error messages
+	label string
+	// messages replaces the messages of errors by code; see Message
+	messages map[string]string
+	// warn reports the rule's errors as warnings; see Warn
+	warn bool
+	// optional skips the rule when the field holds its zero value
+	optional bool
+	// nilPolicy decides how a nil pointer field is handled
+	nilPolicy nilPolicy
+	// description, examples and meta document the field; see Describe,
+	// Example and Meta
+	description string
+	examples    []any
+	meta        map[string]any
+}
+
+// Compile checks every field validator for misconfiguration and returns
+// the first problem found, so bad schemas can be rejected at startup. It
+// also fails when a selector's field name cannot be resolved or a field has
+// more than one unconditional rule. In Strict mode it also fails if any
+// exported struct field has no rule.
+func (s *Schema[T]) Compile() error {
+	seen := make(map[string]bool, len(s.rules))
+	for i, rule := range s.rules {
+		if rule.unresolved {
+			return fmt.Errorf("rule %d: cannot resolve the field its selector returns", i)
+		}
+		if err := compileValidator(rule.validator); err != nil {
+			return fmt.Errorf("field %s: %w", rule.field, err)
+		}
+		if rule.when != nil {
+			continue
+		}
+		if seen[rule.field] {
+			return fmt.Errorf("field %s: duplicate rule", rule.field)
+		}
+		seen[rule.field] = true
+	}
+	if s.strict {
+		if missing := s.unvalidatedFields(); len(missing) > 0 {
+			return fmt.Errorf("fields without validation rules: %s", strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// Strict makes Compile fail when an exported field of T has no rule, so
+// fields added to a struct later cannot silently bypass validation
+func (s *Schema[T]) Strict() *Schema[T] {
+	s.strict = true
+	return s
+}
+
+// unvalidatedFields returns the exported fields of T that have no rule
+func (s *Schema[T]) unvalidatedFields() []string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	covered := make(map[string]bool, len(s.rules))
+	for _, rule := range s.rules {
+		// a rule on Audit.CreatedBy counts as covering Audit
+		name, _, _ := strings.Cut(rule.field, ".")
+		covered[name] = true
+	}
+	var missing []string
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() && !covered[field.Name] {
+			missing = append(missing, field.Name)
+		}
+	}
+	return missing
+}
+
+// CollectAll makes the schema report every rule each field violates
+// instead of only the first one per field
+func (s *Schema[T]) CollectAll() *Schema[T] {
+	s.collectAll = true
+	return s
+}
+
+// FailFast makes the schema stop at the first error instead of validating
+// every field, for callers that only need to know whether a value is valid.
+// It takes precedence over CollectAll.
+func (s *Schema[T]) FailFast() *Schema[T] {
+	s.failFast = true
+	return s
+}
+
+// Warn makes the most recently added field rule non-fatal: its errors are
+// reported as warnings, which HasErrors and Err ignore, so deprecation and
+// soft-limit checks do not block the request
+func (s *Schema[T]) Warn() *Schema[T] {
+	s.lastRule().warn = true
+	return s
+}
+
+// MaxErrors stops validation once n errors have been reported, so huge
+// batch payloads do not allocate more errors than a response will show.
+// Zero, the default, means no limit.
+func (s *Schema[T]) MaxErrors(n int) *Schema[T] {
+	s.maxErrors = n
+	return s
+}
+
+// done reports whether validation should stop because of FailFast or
+// MaxErrors
+func (s *Schema[T]) done(errors *Errors) bool {
+	if s.failFast && errors.HasErrors() {
+		return true
+	}
+	return s.maxErrors > 0 && errors.Len() >= s.maxErrors
+}
+
+// Preprocess adds a function that rewrites the whole value before any
+// field rule runs, for normalization shared by many fields such as
+// trimming every string or filling derived fields. Preprocess functions
+// run in the order they were added; Parse and Normalize return their
+// result, while Validate only validates it.
+func (s *Schema[T]) Preprocess(fn func(T) T) *Schema[T] {
+	s.preprocess = append(s.preprocess, fn)
+	return s
+}
+
+// AfterValidate adds a hook called with the validated value and its errors
+// once all rules and refinements have run, including when validation
+// stops early, for auditing, metrics or error enrichment without wrapping
+// every call site. Hooks run in the order they were added and may add
+// errors; they run before messages are localized.
+func (s *Schema[T]) AfterValidate(fn func(T, *Errors)) *Schema[T] {
+	s.after = append(s.after, fn)
+	return s
+}
+
+// finish runs the AfterValidate hooks
+func (s *Schema[T]) finish(value *T, errs *Errors) {
+	for _, fn := range s.after {
+		fn(*value, errs)
+	}
+}
+
+// Refine adds a struct-level rule that sees the whole value, for
+// cross-field constraints such as matching passwords. Refinements run
+// after all field rules, in the order they were added; the returned
+// error's Field is reported as set by fn.
+func (s *Schema[T]) Refine(fn func(T) *Error) *Schema[T] {
+	s.refinements = append(s.refinements, fn)
+	return s
+}
+
+// StructField is the synthetic field name reported by Check rules
+const StructField = "_struct"
+
+// Check adds a named struct-level rule for whole-object invariants. Its
+// errors are reported under StructField unless fn sets a Field, and use
+// name as their code when fn leaves Code empty, so clients can rely on
+// stable codes.
+func (s *Schema[T]) Check(name string, fn func(T) *Error) *Schema[T] {
+	return s.Refine(func(value T) *Error {
+		result := fn(value)
+		if result == nil {
+			return nil
+		}
+		// fn may return a shared error, so rewrite a copy
+		err := *result
+		if err.Code == "" {
+			err.Code = name
+		}
+		if err.Message == "" {
+			err.Message = fmt.Sprintf("failed check %s", name)
+		}
+		if err.Field == "" {
+			return err.withPrefix(FieldSegment(StructField))
+		}
+		return &err
+	})
+}
+
+// RefineUpdate adds a rule comparing the stored and updated versions of a
+// value, for immutability constraints such as "email cannot change" or
+// "status can only move forward". It only runs in ValidateUpdate; the
+// returned error's Field is reported as set by fn.
+func (s *Schema[T]) RefineUpdate(fn func(old, new T) *Error) *Schema[T] {
+	s.updates = append(s.updates, fn)
+	return s
+}
+
+// ValidateUpdate validates next like Validate and then runs every
+// RefineUpdate rule against old and next
+func (s *Schema[T]) ValidateUpdate(old, next T) *Errors {
+	errs := s.Validate(next)
+	for _, update := range s.updates {
+		if s.done(errs) {
+			break
+		}
+		if err := update(old, next); err != nil {
+			// translate a copy, since update rules may return shared errors
+			copied := *err
+			copied.translate(s.locale)
+			errs.Add(&copied)
+		}
+	}
+	return errs
 }
 
 // Validate runs all validators in the schema and returns any errors
 func (s *Schema[T]) Validate(value T) *Errors {
-	errors := &Errors{}
-	for _, rule := range s.rules {
-		if err := rule.rule.Validate(rule.selector(value)); err != nil {
-			err.Field = rule.field
+	errs, _ := s.run(context.Background(), &value, false)
+	return errs
+}
+
+// ValidateInto validates like Validate but reports into errs, which is
+// reset first, so its storage can be reused across calls. Combined with
+// AcquireErrors and Release, high-throughput services avoid allocating an
+// Errors per request:
+//
+//	errs := validate.AcquireErrors()
+//	defer errs.Release()
+//	schema.ValidateInto(req, errs)
+func (s *Schema[T]) ValidateInto(value T, errs *Errors) {
+	errs.Reset()
+	s.runInto(context.Background(), &value, false, errs)
+}
+
+// ValidateContext validates like Validate, passing ctx to context-aware
+// validators and stopping with a canceled error once ctx is done
+func (s *Schema[T]) ValidateContext(ctx context.Context, value T) *Errors {
+	errs, _ := s.run(ctx, &value, false)
+	return errs
+}
+
+// Parse validates the value like Validate and also returns it with every
+// field's default, transforms and catch fallback applied, for fields whose
+// validator implements Parser
+func (s *Schema[T]) Parse(value T) (T, *Errors) {
+	errs, _ := s.run(context.Background(), &value, true)
+	return value, errs
+}
+
+// ParseResult is the outcome of Schema.Normalize
+type ParseResult[T any] struct {
+	// Value is the parsed value with defaults and transforms applied
+	Value T
+	// Errors holds the validation errors, if any
+	Errors  *Errors
+	changed []string
+}
+
+// Changed returns the fields whose values were modified by parsing, such
+// as defaulted, trimmed or coerced fields, in rule order
+func (r *ParseResult[T]) Changed() []string {
+	return r.changed
+}
+
+// Normalize parses the value like Parse and also reports which fields were
+// modified, so callers can log or audit normalization decisions
+func (s *Schema[T]) Normalize(value T) *ParseResult[T] {
+	errs, changed := s.run(context.Background(), &value, true)
+	return &ParseResult[T]{
+		Value:   value,
+		Errors:  errs,
+		changed: changed,
+	}
+}
+
+// run validates *value; when parse is set, parsed field values are written
+// back into *value so later rules and refinements see the normalized value,
+// and the names of the fields that changed are returned
+func (s *Schema[T]) run(ctx context.Context, value *T, parse bool) (*Errors, []string) {
+	return s.runInto(ctx, value, parse, &Errors{})
+}
+
+// runInto is run reporting into errors
+func (s *Schema[T]) runInto(ctx context.Context, value *T, parse bool, errors *Errors) (*Errors, []string) {
+	var changed []string
+	defer s.localize(errors)
+	defer s.finish(value, errors)
+	all := s.collectAll && !s.failFast
+	if len(s.preprocess) > 0 {
+		original := *value
+		for _, fn := range s.preprocess {
+			*value = fn(*value)
+		}
+		if parse {
+			changed = s.changedFields(original, *value)
+		}
+	}
+	if s.parallel > 1 && !parse && len(s.rules) > 1 {
+		if !s.runParallel(ctx, *value, errors) {
+			return errors, changed
+		}
+	} else {
+		for i := range s.rules {
+			if s.done(errors) {
+				return errors, changed
+			}
+			if err := ctx.Err(); err != nil {
+				errors.Add(contextError(err))
+				return errors, changed
+			}
+			rule := &s.rules[i]
+			for _, err := range s.evalRule(ctx, value, rule, parse, all, &changed) {
+				s.report(errors, rule, err)
+			}
+		}
+	}
+	for _, refine := range s.refinements {
+		if s.done(errors) {
+			return errors, changed
+		}
+		if err := refine(*value); err != nil {
 			errors.Add(err)
 		}
 	}
-	return errors
+	return errors, changed
+}
+
+// evalRule runs one field rule against *value and returns its unreported
+// errors. In parse mode the parsed field is written back to *value and its
+// name appended to changed.
+func (s *Schema[T]) evalRule(ctx context.Context, value *T, rule *FieldRule[T], parse, all bool, changed *[]string) []*Error {
+	if rule.when != nil && !rule.when(*value) {
+		return nil
+	}
+	collect := all || (!s.failFast && collectsAll(rule.validator))
+	if rule.direct != nil && !parse && !collect && !rule.optional &&
+		rule.requiredIf == nil && rule.nilPolicy == nilDefault {
+		err, ok := rule.direct(ctx, *value)
+		if !ok {
+			return s.missingField(*value, rule)
+		}
+		if err != nil {
+			return []*Error{err}
+		}
+		return nil
+	}
+	fieldValue, ok := selectField(rule.selector, *value)
+	if !ok {
+		return s.missingField(*value, rule)
+	}
+	if isNilPointer(fieldValue) {
+		if rule.nilPolicy == nilRequired {
+			return []*Error{requiredError()}
+		}
+		if rule.nilPolicy == nilSkip {
+			return nil
+		}
+	}
+	if rule.optional && isZeroAny(fieldValue) {
+		return nil
+	}
+	if rule.requiredIf != nil && isZeroAny(fieldValue) {
+		if rule.requiredIf(*value) {
+			return []*Error{requiredError()}
+		}
+		return nil
+	}
+	if parse && rule.parse != nil {
+		parsed, err := rule.parse(fieldValue)
+		if !reflect.DeepEqual(parsed, fieldValue) {
+			if updated, ok := setField(*value, rule.path, parsed); ok {
+				*value = updated
+				if name := s.reportedName(rule); !containsString(*changed, name) {
+					*changed = append(*changed, name)
+				}
+			}
+		}
+		if !collect || rule.ruleAll == nil {
+			if err != nil {
+				return []*Error{err}
+			}
+			return nil
+		}
+	}
+	return rule.check(ctx, fieldValue, collect)
+}
+
+// missingField handles a rule whose selector dereferenced a nil pointer
+// on the way to its field. The field counts as absent: SkipIfNil and
+// Optional skip the rule, RequiredIf decides by its condition, and
+// otherwise the field is reported as required.
+func (s *Schema[T]) missingField(value T, rule *FieldRule[T]) []*Error {
+	switch {
+	case rule.nilPolicy == nilSkip, rule.optional:
+		return nil
+	case rule.requiredIf != nil && !rule.requiredIf(value):
+		return nil
+	}
+	return []*Error{requiredError()}
+}
+
+// requiredError is reported for a missing required field
+func requiredError() *Error {
+	return &Error{
+		Code:    "required",
+		Message: "field is required",
+	}
+}
+
+// changedFields returns the names of the rule fields that differ between
+// before and after
+func (s *Schema[T]) changedFields(before, after T) []string {
+	var changed []string
+	for i := range s.rules {
+		rule := &s.rules[i]
+		old, _ := selectField(rule.selector, before)
+		current, _ := selectField(rule.selector, after)
+		if name := s.reportedName(rule); !reflect.DeepEqual(old, current) && !containsString(changed, name) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// setField returns value with v stored in the field named by a dotted
+// path when the field exists, is reachable without a nil pointer and v's
+// type is assignable to it, and reports whether it did. It works on a
+// copy so callers' values do not escape to the heap when not parsing.
+func setField[T any](value T, name string, v any) (T, bool) {
+	field := reflect.ValueOf(&value).Elem()
+	if name == "" || v == nil {
+		return value, false
+	}
+	for _, part := range strings.Split(name, ".") {
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				return value, false
+			}
+			field = field.Elem()
+		}
+		if field.Kind() != reflect.Struct {
+			return value, false
+		}
+		field = field.FieldByName(part)
+	}
+	if !field.IsValid() || !field.CanSet() {
+		return value, false
+	}
+	val := reflect.ValueOf(v)
+	if !val.Type().AssignableTo(field.Type()) {
+		return value, false
+	}
+	field.Set(val)
+	return value, true
+}
+
+// reportedName returns the name the rule's field is reported under
+func (s *Schema[T]) reportedName(rule *FieldRule[T]) string {
+	if s.jsonNames {
+		return jsonName[T](rule.field)
+	}
+	return rule.field
+}
+
+// report attributes err to the rule's field and adds it to errors, unless
+// MaxErrors has been reached
+func (s *Schema[T]) report(errors *Errors, rule *FieldRule[T], err *Error) {
+	if s.maxErrors > 0 && errors.Len() >= s.maxErrors {
+		return
+	}
+	name := s.reportedName(rule)
+	// withPrefix copies err, so the rewrites below never reach errors
+	// shared between calls
+	err = err.withPrefix(dottedPath(name)...)
+	if message, ok := rule.messages[err.Code]; ok {
+		err.Message = message
+		err.fixed = true
+	} else if rule.label != "" {
+		err.Message = labelMessage(rule.label, err.Message)
+	}
+	if err.label == "" {
+		err.label = rule.label
+	}
+	if rule.warn {
+		err.Severity = SeverityWarning
+	}
+	errors.Add(err)
+}
+
+// isZeroAny reports whether v is nil or the zero value of its type
+func isZeroAny(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case int64:
+		return v == 0
+	case bool:
+		return !v
+	}
+	return reflect.ValueOf(v).IsZero()
 }
@@ -0,0 +1,91 @@
+package validate
+
+import "context"
+
+// WrapOption changes how a wrapped validator's errors are presented
+type WrapOption func(*Error)
+
+// Message replaces the message of every error of a wrapped validator
+func Message(message string) WrapOption {
+	return func(err *Error) {
+		err.Message = message
+		err.fixed = true
+	}
+}
+
+// Code replaces the code of every error of a wrapped validator
+func Code(code string) WrapOption {
+	return func(err *Error) {
+		err.Code = code
+	}
+}
+
+// WrappedValidator rewrites the errors of another validator
+type WrappedValidator[T any] struct {
+	validator Validator[T]
+	options   []WrapOption
+}
+
+var _ ContextValidator[string] = (*WrappedValidator[string])(nil)
+
+// Wrap overrides the error presentation of validator without modifying it,
+// for third-party or composed validators that lack WithMessage, e.g.
+//
+//	validate.Wrap[string](validate.OneOf[string](phone, email),
+//		validate.Message("Enter a phone number or email address"),
+//		validate.Code("invalid_contact"))
+//
+// Params, Cause and paths of the errors are kept
+func Wrap[T any](validator Validator[T], options ...WrapOption) *WrappedValidator[T] {
+	return &WrappedValidator[T]{
+		validator: validator,
+		options:   options,
+	}
+}
+
+// Compile reports misconfiguration of the wrapped validator
+func (v *WrappedValidator[T]) Compile() error {
+	return compileValidator(v.validator)
+}
+
+// Validate implements the Validator interface
+func (v *WrappedValidator[T]) Validate(value T) *Error {
+	return v.rewrite(v.validator.Validate(value))
+}
+
+// ValidateAll returns every error of the wrapped validator
+func (v *WrappedValidator[T]) ValidateAll(value T) []*Error {
+	errs := validateAll(v.validator, value)
+	for i, err := range errs {
+		errs[i] = v.rewrite(err)
+	}
+	return errs
+}
+
+// ValidateContext implements the ContextValidator interface
+func (v *WrappedValidator[T]) ValidateContext(ctx context.Context, value T) *Error {
+	return v.rewrite(validateContext(ctx, v.validator, value))
+}
+
+// Parse returns the parsed value when the wrapped validator implements
+// Parser and value unchanged otherwise
+func (v *WrappedValidator[T]) Parse(value T) (T, *Error) {
+	if parser, ok := v.validator.(Parser[T]); ok {
+		parsed, err := parser.Parse(value)
+		return parsed, v.rewrite(err)
+	}
+	return value, v.Validate(value)
+}
+
+// rewrite applies the options to a copy of err, leaving errors the wrapped
+// validator may reuse untouched
+func (v *WrappedValidator[T]) rewrite(err *Error) *Error {
+	if err == nil {
+		return nil
+	}
+	rewritten := *err
+	for _, option := range v.options {
+		option(&rewritten)
+	}
+	return &rewritten
+}